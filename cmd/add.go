@@ -2,22 +2,38 @@ package cmd
 
 import (
 	"fmt"
-	"strconv"
 	"time"
 
-	"github.com/kiku99/morama/internal/config"
 	"github.com/kiku99/morama/internal/models"
 	"github.com/kiku99/morama/internal/storage"
 	"github.com/kiku99/morama/internal/utils"
-	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 )
 
 var addCmd = &cobra.Command{
 	Use:   "add [title]",
-	Short: "Add a new movie or drama entry",
-	Long:  "Add a new movie or drama entry with interactive rating and comment input",
-	Args:  cobra.ExactArgs(1),
+	Short: "Add a new entry",
+	Long: `Add a new movie, drama, anime, documentary, variety show or miniseries entry
+(or a type defined under types in ~/.morama/config.yaml) with interactive rating
+and comment input.
+The watch date defaults to today; use --date to log something you watched earlier.
+Pass --rating and --comment (or --no-input) to skip the prompts in scripts.
+--review opens $VISUAL or $EDITOR on a Markdown file for a long-form review
+alongside the one-line comment; --review-file reads it from a file instead.
+Adding a title you have already logged records a rewatch with its own date,
+rating and comment.
+
+Examples:
+  morama add "인셉션" --type movie
+  morama add "Hospital Playlist" --type drama --date 2024-03-01
+  morama add "Frieren" --type anime --status watching --season 2 --episode 3
+  morama add "Past Lives" --type movie --date "last friday"
+  morama add "Arrival" --type movie --tag sf --tag "slow burn"
+  morama add "Parasite" --type movie --rating 5 --comment "Masterpiece" --no-input
+  morama add "Past Lives" --type movie --rating 5 --comment "Quietly devastating" --review
+  morama add "Decision to Leave" --type movie --rating 4.5 --review-file review.md --no-input
+  echo "Long day, great show" | morama add "Mr. Sunshine" --type drama --rating 4.5 --comment -`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		startTime := time.Now()
 		defer func() {
@@ -28,54 +44,12 @@ var addCmd = &cobra.Command{
 		utils.LogUserAction("add_entry", fmt.Sprintf("title: %s", title))
 
 		// Determine media type from flags
-		isMovie, _ := cmd.Flags().GetBool("movie")
-		isDrama, _ := cmd.Flags().GetBool("drama")
-
-		var mediaType models.MediaType
-		if isMovie && isDrama {
-			utils.HandleError(
-				utils.ValidationError("Cannot specify both --movie and --drama flags", nil),
-				"Invalid media type specification",
-			)
-		} else if isMovie {
-			mediaType = models.Movie
-		} else if isDrama {
-			mediaType = models.Drama
-		} else {
-			utils.HandleError(
-				utils.ValidationError("Must specify either --movie or --drama flag", nil),
-				"Missing media type specification",
-			)
-		}
-
-		// Interactive rating input
-		ratingPrompt := promptui.Prompt{
-			Label:    "Rate",
-			Validate: validateRating,
-		}
-
-		ratingStr, err := ratingPrompt.Run()
+		mediaType, err := requiredMediaType(cmd)
 		if err != nil {
-			utils.HandleError(
-				utils.UserInputError("Failed to get rating input", err),
-				"Rating input error",
-			)
-		}
-
-		rating, _ := strconv.ParseFloat(ratingStr, 64)
-
-		// Interactive comment input
-		commentPrompt := promptui.Prompt{
-			Label: "One-line Review",
+			utils.HandleError(err, "Invalid media type specification")
 		}
 
-		comment, err := commentPrompt.Run()
-		if err != nil {
-			utils.HandleError(
-				utils.UserInputError("Failed to get comment input", err),
-				"Comment input error",
-			)
-		}
+		entry := newEntryFromInput(cmd, title, mediaType)
 
 		// Load storage
 		store, err := storage.NewStorage()
@@ -87,42 +61,110 @@ var addCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		// Create new entry
-		entry := models.MediaEntry{
-			Title:   title,
-			Type:    mediaType,
-			Rating:  rating,
-			Comment: comment,
-		}
-
 		// Add entry
-		if err := store.AddEntry(entry); err != nil {
+		id, err := store.AddEntry(entry)
+		if err != nil {
 			utils.HandleError(
-				utils.DatabaseError("Failed to save entry", err),
+				entryWriteError(err, "Failed to save entry"),
 				"Entry save error",
 			)
 		}
 
-		utils.LogUserAction("entry_added", fmt.Sprintf("title: %s, type: %s, rating: %.1f", title, mediaType, rating))
+		utils.LogUserAction("entry_added", fmt.Sprintf("id: %d, title: %s, type: %s, rating: %s", id, title, mediaType, formatRating(entry.Rating)))
 		fmt.Println("✅ Successfully saved!")
+
+		// 이미 본 작품이면 다시 본 기록으로 안내
+		if viewings, err := store.FindAllByTitleAndType(title, mediaType); err == nil && len(viewings) > 1 {
+			fmt.Printf("🔁 Logged viewing #%d of %s — see the timeline with 'morama show'\n", len(viewings), title)
+		}
 	},
 }
 
-func validateRating(input string) error {
-	rating, err := strconv.ParseFloat(input, 64)
+// newEntryFromInput 플래그와 프롬프트로 새 항목 구성 (add, watchlist promote 공용)
+// 시청일, 평점, 한줄평, 태그, 시청 상태/회차를 읽고 잘못된 값이면 종료
+func newEntryFromInput(cmd *cobra.Command, title string, mediaType models.MediaType) models.MediaEntry {
+	if !mediaType.Info().Episodic && episodeFlagsChanged(cmd) {
+		utils.HandleError(
+			utils.ValidationError(fmt.Sprintf("%s entries have no episodes to track", mediaType.Label()), nil),
+			"Invalid progress",
+		)
+	}
+
+	dateWatched, err := watchedDateFromFlag(cmd)
 	if err != nil {
-		return fmt.Errorf("invalid number")
+		utils.HandleError(err, "Invalid watch date")
 	}
 
-	cfg := config.GetConfig()
-	if rating < 0 || rating > cfg.Display.RatingScale {
-		return fmt.Errorf("rating must be between 0 and %.1f", cfg.Display.RatingScale)
+	// Rating and comment: flags first, interactive prompts otherwise
+	rating, err := ratingInput(cmd, nil)
+	if err != nil {
+		utils.HandleError(err, "Rating input error")
+	}
+
+	comment, err := commentInput(cmd, "One-line Review", "")
+	if err != nil {
+		utils.HandleError(err, "Comment input error")
+	}
+
+	review, err := reviewInput(cmd, title, "")
+	if err != nil {
+		utils.HandleError(err, "Review input error")
+	}
+
+	tags, _ := tagsInput(cmd)
+
+	entry := models.MediaEntry{
+		Title:       title,
+		Type:        mediaType,
+		Rating:      rating,
+		Comment:     comment,
+		Review:      review,
+		DateWatched: dateWatched,
+		Tags:        tags,
+	}
+
+	// 시청 상태/회차 (기본: 다 본 것으로 기록)
+	if progressFlagsChanged(cmd) {
+		if err := applyProgressFlags(cmd, &entry, ""); err != nil {
+			utils.HandleError(err, "Invalid progress")
+		}
+	}
+	return entry
+}
+
+// addNewEntryFlags newEntryFromInput이 읽는 플래그 등록
+func addNewEntryFlags(cmd *cobra.Command) {
+	cmd.Flags().String("date", "", "Watch date (YYYY-MM-DD, today, yesterday, last friday, 3 days ago)")
+	addEntryInputFlags(cmd)
+	addProgressFlags(cmd)
+}
+
+// 설정된 척도의 평점인지 검사
+func validateRating(input string) error {
+	_, err := ratingScale().Parse(input)
+	return err
+}
+
+// --date 값을 시청일로 변환 (지정하지 않으면 0 값 → 저장 시 현재 시각)
+func watchedDateFromFlag(cmd *cobra.Command) (time.Time, error) {
+	dateStr, _ := cmd.Flags().GetString("date")
+	if dateStr == "" {
+		return time.Time{}, nil
+	}
+
+	now := time.Now()
+	date, err := utils.ParseDate(dateStr, now)
+	if err != nil {
+		return time.Time{}, utils.ValidationError(err.Error(), err)
+	}
+	if date.After(now) {
+		return time.Time{}, utils.ValidationError(fmt.Sprintf("Watch date %s is in the future", date.Format("2006-01-02")), nil)
 	}
-	return nil
+	return date, nil
 }
 
 func init() {
 	rootCmd.AddCommand(addCmd)
-	addCmd.Flags().Bool("movie", false, "Add as a movie")
-	addCmd.Flags().Bool("drama", false, "Add as a drama")
+	addTypeFlag(addCmd, "Media type (movie, drama, anime, documentary, variety, miniseries, ...)", true)
+	addNewEntryFlags(addCmd)
 }
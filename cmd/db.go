@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance commands",
+	Long:  "Inspect and maintain the morama database schema",
+}
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending schema migrations",
+	Long: `Applies pending schema migrations to the morama database.
+Migrations also run automatically whenever another command opens the database.
+
+Examples:
+  morama db migrate            # Migrate to the latest version
+  morama db migrate --status   # Show applied and pending migrations
+  morama db migrate --to 1     # Migrate up to version 1`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("db migrate", args, time.Since(startTime))
+		}()
+
+		showStatus, _ := cmd.Flags().GetBool("status")
+		target, _ := cmd.Flags().GetInt("to")
+
+		store, err := storage.OpenStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to open the database", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		if showStatus {
+			printMigrationStatus(cmd, store)
+			return
+		}
+
+		if !cmd.Flags().Changed("to") {
+			if target, err = storage.LatestVersion(); err != nil {
+				utils.HandleError(
+					utils.SystemError("Failed to load migrations", err),
+					"Migration load error",
+				)
+			}
+		}
+
+		applied, err := store.MigrateTo(target)
+		for _, m := range applied {
+			fmt.Printf("⬆️  Applied %04d_%s\n", m.Version, m.Name)
+		}
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError(err.Error(), err),
+				"Migration error",
+			)
+		}
+
+		if len(applied) == 0 {
+			fmt.Println("✅ Database schema is already up to date.")
+			return
+		}
+
+		utils.LogUserAction("db_migrated", fmt.Sprintf("applied %d migrations, target: %d", len(applied), target))
+		fmt.Printf("✅ Schema migrated to version %d.\n", target)
+	},
+}
+
+func printMigrationStatus(cmd *cobra.Command, store *storage.Storage) {
+	states, err := store.MigrationStatus()
+	if err != nil {
+		utils.HandleError(
+			utils.DatabaseError("Failed to read migration status", err),
+			"Migration status error",
+		)
+	}
+	if renderOutput(cmd, newMigrationTable(states)) {
+		return
+	}
+
+	fmt.Printf("%s %s %s %s\n",
+		utils.PadStringToWidth("Version", 8),
+		utils.PadStringToWidth("Name", 24),
+		utils.PadStringToWidth("Status", 10),
+		"Applied At")
+
+	for _, state := range states {
+		status := "pending"
+		appliedAt := "-"
+		if state.Applied {
+			status = "applied"
+			appliedAt = state.AppliedAt.Format("2006-01-02 15:04:05")
+		}
+
+		fmt.Printf("%s %s %s %s\n",
+			utils.PadStringToWidth(fmt.Sprintf("%04d", state.Version), 8),
+			utils.PadStringToWidth(state.Name, 24),
+			utils.PadStringToWidth(status, 10),
+			appliedAt)
+	}
+}
+
+// migrationStatus --output용 마이그레이션 상태 한 건 (SQL 본문은 제외)
+type migrationStatus struct {
+	Version   int        `json:"version" yaml:"version"`
+	Name      string     `json:"name" yaml:"name"`
+	Applied   bool       `json:"applied" yaml:"applied"`
+	AppliedAt *time.Time `json:"applied_at" yaml:"applied_at"`
+}
+
+// migrationTable --output용 마이그레이션 상태 목록
+type migrationTable []migrationStatus
+
+func newMigrationTable(states []storage.MigrationState) migrationTable {
+	table := make(migrationTable, len(states))
+	for i, state := range states {
+		table[i] = migrationStatus{Version: state.Version, Name: state.Name, Applied: state.Applied}
+		if state.Applied {
+			appliedAt := state.AppliedAt
+			table[i].AppliedAt = &appliedAt
+		}
+	}
+	return table
+}
+
+func (t migrationTable) Header() []string {
+	return []string{"version", "name", "status", "applied_at"}
+}
+
+func (t migrationTable) Rows() [][]string {
+	rows := make([][]string, len(t))
+	for i, m := range t {
+		status, appliedAt := "pending", ""
+		if m.Applied {
+			status = "applied"
+			appliedAt = m.AppliedAt.Format(time.RFC3339)
+		}
+		rows[i] = []string{strconv.Itoa(m.Version), m.Name, status, appliedAt}
+	}
+	return rows
+}
+
+func init() {
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbMigrateCmd.Flags().Bool("status", false, "Show applied and pending migrations")
+	dbMigrateCmd.Flags().Int("to", 0, "Migrate up to the given schema version")
+}
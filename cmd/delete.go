@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -12,21 +14,42 @@ var (
 	deleteAll bool
 )
 
+const undoHint = "↩️ Changed your mind? Run 'morama undo' or 'morama trash restore <id>'."
+
 var deleteCmd = &cobra.Command{
-	Use:   "delete",
-	Short: "Delete a record",
-	Long: `Deletes a record. You can delete a single entry using --id, or all entries using --all.
+	Use:   "delete [id...]",
+	Short: "Move records to the trash",
+	Long: `Moves records to the trash by ID. IDs can be listed as arguments, including
+ranges, or given with --id. Use --all to delete every entry; it asks for
+confirmation unless --yes is given.
+
+Entries in the trash are hidden from list, show and stats until you restore
+them with 'morama trash restore' or remove them for good with 'morama trash purge'.
 
 Examples:
+  morama delete 3          # Delete entry with ID 3
+  morama delete 12 15 20   # Delete several entries
+  morama delete 10-20      # Delete entries 10 through 20
   morama delete --id=3     # Delete entry with ID 3
-  morama delete --all      # Delete all entries`,
+  morama delete --all      # Delete all entries (asks first)
+  morama delete --all --yes
+
+Deletes are recorded and can be reverted with 'morama undo'.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		if deleteID == 0 && !deleteAll {
-			fmt.Println("❌ Please specify either --id or --all to delete entries.")
+		ids, err := utils.ParseIDList(args)
+		if err != nil {
+			utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid ID")
+		}
+		if deleteID > 0 {
+			ids = append(ids, deleteID)
+		}
+
+		if len(ids) == 0 && !deleteAll {
+			fmt.Println("❌ Please specify IDs, --id or --all to delete entries.")
 			return
 		}
-		if deleteID > 0 && deleteAll {
-			fmt.Println("❌ --id and --all cannot be used together.")
+		if len(ids) > 0 && deleteAll {
+			fmt.Println("❌ IDs and --all cannot be used together.")
 			return
 		}
 
@@ -38,30 +61,76 @@ Examples:
 		defer store.Close()
 
 		if deleteAll {
+			total, err := store.CountEntries(storage.Query{})
+			if err != nil {
+				utils.HandleError(utils.DatabaseError("Failed to count entries", err), "Delete error")
+			}
+			if total == 0 {
+				fmt.Println("📭 There are no entries to delete.")
+				return
+			}
+
+			ok, err := confirmAction(cmd, fmt.Sprintf("Move all %d entries to the trash", total))
+			if err != nil {
+				utils.HandleError(err, "Confirmation required")
+			}
+			if !ok {
+				fmt.Println("❎ Cancelled; nothing was deleted.")
+				return
+			}
+
 			count, err := store.DeleteAll()
 			if err != nil {
 				fmt.Printf("❌ Failed to delete all entries: %v\n", err)
 				return
 			}
-			fmt.Printf("🗑️ Deleted all %d entries.\n", count)
+			utils.LogUserAction("entries_deleted", fmt.Sprintf("all: %d entries", count))
+			fmt.Printf("🗑️ Moved all %d entries to the trash.\n", count)
+			fmt.Println(undoHint)
 			return
 		}
 
-		deleted, err := store.DeleteByID(deleteID)
+		deleted, err := store.DeleteByIDs(ids)
 		if err != nil {
 			fmt.Printf("❌ Failed to delete entry: %v\n", err)
 			return
 		}
-		if deleted == 0 {
-			fmt.Printf("⚠️ No entry found with ID %d.\n", deleteID)
-		} else {
-			fmt.Printf("🗑️ Deleted entry with ID %d.\n", deleteID)
+
+		for _, id := range deleted {
+			fmt.Printf("🗑️ Moved entry %d to the trash.\n", id)
+		}
+		if len(deleted) > 0 {
+			fmt.Println(undoHint)
+		}
+
+		if missing := missingIDs(ids, deleted); len(missing) > 0 {
+			utils.HandleError(
+				utils.NotFoundError(fmt.Sprintf("No entry found with ID %s", strings.Join(missing, ", ")), nil),
+				"Entry not found",
+			)
 		}
 	},
 }
 
+// 요청했지만 삭제되지 않은 ID
+func missingIDs(requested, deleted []int) []string {
+	found := make(map[int]bool, len(deleted))
+	for _, id := range deleted {
+		found[id] = true
+	}
+
+	var missing []string
+	for _, id := range requested {
+		if !found[id] {
+			missing = append(missing, fmt.Sprintf("%d", id))
+		}
+	}
+	return missing
+}
+
 func init() {
 	rootCmd.AddCommand(deleteCmd)
 	deleteCmd.Flags().IntVar(&deleteID, "id", 0, "ID of the entry to delete")
 	deleteCmd.Flags().BoolVar(&deleteAll, "all", false, "Delete all entries")
+	deleteCmd.Flags().BoolP("yes", "y", false, "Don't ask for confirmation with --all")
 }
@@ -6,42 +6,52 @@ import (
 
 	"github.com/kiku99/morama/internal/models"
 	"github.com/kiku99/morama/internal/storage"
-	"github.com/manifoldco/promptui"
+	"github.com/kiku99/morama/internal/utils"
 	"github.com/spf13/cobra"
 )
 
 var editCmd = &cobra.Command{
-	Use:   "edit [title]",
-	Short: "Edit an existing movie or drama entry",
-	Long: `Edit an existing movie or drama entry by its ID.
+	Use:   "edit [title|id]",
+	Short: "Edit an existing entry",
+	Long: `Edit an existing entry by its ID.
+The ID can be given directly as the argument, or with --id alongside the title.
+If --id is omitted and several entries match the title, you can pick one.
+--type narrows the lookup when entries of different types share the title.
 Example:
-  morama edit "Drama Title" --id=3 --drama
-  morama edit "Movie Title" --id=5 --movie`,
+  morama edit 12
+  morama edit "Drama Title"
+  morama edit "Drama Title" --id=3 --type drama
+  morama edit "Movie Title" --id=5 --type movie
+  morama edit "Movie Title" --id=5 --date 2024-12-25
+  morama edit "Movie Title" --id=5 --rating 4 --no-input
+  morama edit 12 --review                # Write or revise the long-form review in $EDITOR
+  morama edit 12 --tag thriller,korean   # Replace the entry's tags
+  morama edit 12 --tag ""                # Remove all tags`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		title := args[0]
 		idStr, _ := cmd.Flags().GetString("id")
-		isMovie, _ := cmd.Flags().GetBool("movie")
-		isDrama, _ := cmd.Flags().GetBool("drama")
 
-		// ID 파싱
-		id, err := strconv.Atoi(idStr)
-		if err != nil {
-			fmt.Println("❌ Error: Invalid ID format")
-			return
+		// ID 파싱 (생략하면 제목으로 찾은 항목 중에서 선택)
+		id := 0
+		if idStr != "" {
+			var err error
+			if id, err = strconv.Atoi(idStr); err != nil {
+				fmt.Println("❌ Error: Invalid ID format")
+				return
+			}
 		}
 
-		// 미디어 타입 확인
-		if (isMovie && isDrama) || (!isMovie && !isDrama) {
-			fmt.Println("❌ Error: Please specify either --movie or --drama (but not both)")
-			return
+		// 미디어 타입 확인 (선택 사항: 같은 제목의 다른 타입 작품을 구분할 때만 필요)
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
 		}
 
-		var mediaType models.MediaType
-		if isMovie {
-			mediaType = models.Movie
-		} else {
-			mediaType = models.Drama
+		// 시청일 변경 (지정하지 않으면 기존 값 유지)
+		dateWatched, err := watchedDateFromFlag(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid watch date")
 		}
 
 		// 스토리지 초기화
@@ -52,64 +62,76 @@ Example:
 		}
 		defer store.Close()
 
-		// 기존 항목 조회
-		entries, err := store.FindAllByTitleAndType(title, mediaType)
+		// 기존 항목 조회: ID 인자이거나, 제목(search.fuzzy_match 설정에 따라 비슷한 제목 포함)
+		entries, byID, err := resolveEntryArg(store, title, mediaType)
 		if err != nil {
-			fmt.Printf("❌ Error finding entry: %v\n", err)
-			return
+			utils.HandleError(err, "Error finding entry")
 		}
 
-		// ID로 항목 찾기
 		var targetEntry *models.MediaEntry
-		for _, entry := range entries {
-			if entry.ID == id {
-				targetEntry = &entry
-				break
+		if byID && id == 0 {
+			targetEntry = &entries[0]
+		} else if id != 0 {
+			// ID로 항목 찾기
+			for _, entry := range entries {
+				if entry.ID == id {
+					targetEntry = &entry
+					break
+				}
+			}
+		} else {
+			// 같은 제목의 다른 타입 작품 구분 후, 여러 번 본 항목 중 선택
+			key, err := selectTitle(cmd, title, entries)
+			if err != nil {
+				utils.HandleError(err, "Title selection error")
+			}
+			entries = filterByTitle(entries, key)
+
+			targetEntry, err = selectEntry(cmd, fmt.Sprintf("Several entries match %s", key), entries)
+			if err != nil {
+				utils.HandleError(err, "Entry selection error")
 			}
 		}
 
 		if targetEntry == nil {
-			fmt.Printf("❌ Error: No entry found with ID %d for \"%s\" (%s)\n", id, title, mediaType)
-			return
+			utils.HandleError(
+				utils.NotFoundError(fmt.Sprintf("No entry found with ID %d for \"%s\"", id, title), nil),
+				"Entry lookup error",
+			)
 		}
 
-		// Interactive rating input
-		ratingPrompt := promptui.Prompt{
-			Label:    "Rate",
-			Validate: validateRating,
-			Default:  fmt.Sprintf("%.1f", targetEntry.Rating),
+		// 평점/한줄평: 플래그 우선, 없으면 기존 값을 기본값으로 프롬프트
+		rating, err := ratingInput(cmd, &targetEntry.Rating)
+		if err != nil {
+			utils.HandleError(err, "Rating input error")
 		}
 
-		ratingStr, err := ratingPrompt.Run()
+		comment, err := commentInput(cmd, "한줄평", targetEntry.Comment)
 		if err != nil {
-			fmt.Printf("❌ Error getting rating: %v\n", err)
-			return
+			utils.HandleError(err, "Comment input error")
 		}
 
-		rating, _ := strconv.ParseFloat(ratingStr, 64)
-
-		// Interactive comment input
-		commentPrompt := promptui.Prompt{
-			Label:   "한줄평",
-			Default: targetEntry.Comment,
+		// 감상문: --review/--review-file을 지정한 경우에만 변경
+		review, err := reviewInput(cmd, targetEntry.Title, targetEntry.Review)
+		if err != nil {
+			utils.HandleError(err, "Review input error")
 		}
 
-		comment, err := commentPrompt.Run()
-		if err != nil {
-			fmt.Printf("❌ Error getting comment: %v\n", err)
-			return
+		if dateWatched.IsZero() {
+			dateWatched = targetEntry.DateWatched
 		}
 
-		// Update entry
-		updatedEntry := models.MediaEntry{
-			Title:       title,
-			Type:        mediaType,
-			Rating:      rating,
-			Comment:     comment,
-			DateWatched: targetEntry.DateWatched,
+		// 태그는 --tag를 지정한 경우에만 교체하고, 나머지 필드와 함께 한 번에 저장 (undo 한 번으로 되돌림)
+		updatedEntry := *targetEntry
+		updatedEntry.Rating = rating
+		updatedEntry.Comment = comment
+		updatedEntry.Review = review
+		updatedEntry.DateWatched = dateWatched
+		if tags, changed := tagsInput(cmd); changed {
+			updatedEntry.Tags = tags
 		}
 
-		if err := store.UpdateEntry(id, updatedEntry); err != nil {
+		if err := store.ReplaceEntry(targetEntry.ID, updatedEntry); err != nil {
 			fmt.Printf("❌ Error updating entry: %v\n", err)
 			return
 		}
@@ -120,8 +142,8 @@ Example:
 
 func init() {
 	rootCmd.AddCommand(editCmd)
-	editCmd.Flags().String("id", "", "ID of the entry to edit")
-	editCmd.Flags().Bool("movie", false, "Edit as a movie")
-	editCmd.Flags().Bool("drama", false, "Edit as a drama")
-	editCmd.MarkFlagRequired("id")
+	editCmd.Flags().String("id", "", "ID of the entry to edit (prompted if several entries match)")
+	addTypeFlag(editCmd, "Only match this media type (when titles are shared across types)", true)
+	editCmd.Flags().String("date", "", "New watch date (YYYY-MM-DD, yesterday, last friday, ...)")
+	addEntryInputFlags(editCmd)
 }
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/transfer"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export entries as CSV, JSON or JSON Lines",
+	Long: `Exports every recorded entry with stable column names
+(id, title, type, rating, comment, review, date_watched, created_at, tags, status,
+season, episodes_watched, total_episodes), so 'morama import' restores them as they were.
+With --format letterboxd, movies are written in Letterboxd's diary.csv layout
+with ratings converted to 0.5–5 stars.
+
+The file layout is chosen with --format and the destination with --out; the
+global --output flag only controls how other commands print and is rejected here.
+
+Examples:
+  morama export --format csv --out morama.csv
+  morama export --format jsonl --year 2024 --type drama
+  morama export --out backup.json              # format inferred from extension
+  morama export --format letterboxd --out diary.csv`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("export", args, time.Since(startTime))
+		}()
+
+		// 전역 --output은 화면 출력 형식이므로 export에서는 --format/--out으로 안내
+		if cmd.Flags().Changed("output") {
+			value, _ := cmd.Flags().GetString("output")
+			utils.HandleError(
+				utils.ValidationError(fmt.Sprintf(
+					"export does not use --output; choose the file format with --format (e.g. --format %s) and the file with --out", value), nil),
+				"Invalid export option",
+			)
+		}
+
+		formatStr, _ := cmd.Flags().GetString("format")
+		outPath, _ := cmd.Flags().GetString("out")
+		year, _ := cmd.Flags().GetInt("year")
+
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		format, err := resolveFormat(formatStr, outPath, cmd.Flags().Changed("format"))
+		if err != nil {
+			utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid export format")
+		}
+
+		// Letterboxd는 영화만 다룸
+		if format == transfer.FormatLetterboxd {
+			if mediaType != "" && mediaType != models.Movie {
+				utils.HandleError(
+					utils.ValidationError("The letterboxd format only supports movies", nil),
+					"Invalid export format",
+				)
+			}
+			mediaType = models.Movie
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		var out io.Writer = os.Stdout
+		if outPath != "" && outPath != "-" {
+			file, err := os.Create(outPath)
+			if err != nil {
+				utils.HandleError(
+					utils.SystemError(fmt.Sprintf("Failed to create %s", outPath), err),
+					"Export file error",
+				)
+			}
+			defer file.Close()
+			out = file
+		}
+
+		writer, err := transfer.NewWriter(out, format, transfer.Options{
+			Scale: ratingScale(),
+		})
+		if err != nil {
+			utils.HandleError(utils.SystemError("Failed to start export", err), "Export error")
+		}
+
+		count := 0
+		// 오래된 순으로 기록
+		query := storage.Query{Type: mediaType, Year: year, Sort: storage.SortDate, Reverse: true}
+		err = store.StreamEntries(query, func(entry models.MediaEntry) error {
+			count++
+			return writer.Write(entry)
+		})
+		if err == nil {
+			err = writer.Close()
+		}
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to export entries", err), "Export error")
+		}
+
+		utils.LogUserAction("entries_exported", fmt.Sprintf("format: %s, count: %d", format, count))
+		if out != os.Stdout {
+			fmt.Printf("✅ Exported %d entries to %s\n", count, outPath)
+		}
+	},
+}
+
+// --format이 없으면 출력 파일 확장자로, 그것도 없으면 CSV
+func resolveFormat(formatStr, path string, explicit bool) (transfer.Format, error) {
+	if explicit {
+		return transfer.ParseFormat(formatStr)
+	}
+	if format, ok := transfer.FormatFromPath(path); ok {
+		return format, nil
+	}
+	return transfer.ParseFormat(formatStr)
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringP("format", "f", "csv", "Output format: csv, json, jsonl or letterboxd")
+	exportCmd.Flags().StringP("out", "o", "", "Output file (default: stdout)")
+	exportCmd.Flags().Int("year", 0, "Only export entries watched in this year")
+	addTypeFlag(exportCmd, "Only export this media type", true)
+}
@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List recent changes that can be undone",
+	Long: `Lists recent adds, edits, tag changes, deletes and imports, newest first.
+Operations marked "undone" can be re-applied with 'morama redo'.
+
+Examples:
+  morama history
+  morama history --limit 50
+  morama history --output json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("history", args, time.Since(startTime))
+		}()
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		if limit < 0 {
+			utils.HandleError(utils.ValidationError("--limit must not be negative", nil), "Invalid limit")
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		operations, err := store.History(limit)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to load history", err), "History error")
+		}
+		if renderOutput(cmd, journalTable(operations)) {
+			return
+		}
+		if len(operations) == 0 {
+			fmt.Println("📭 No changes recorded yet.")
+			return
+		}
+
+		layout := config.GetConfig().Display.DateFormat + " 15:04"
+		fmt.Printf("%5s  %-16s  %-7s  %s\n", "#", "When", "Action", "Summary")
+		fmt.Println(strings.Repeat("─", 70))
+		for _, op := range operations {
+			line := fmt.Sprintf("%5d  %-16s  %-7s  %s", op.ID, op.CreatedAt.Local().Format(layout), op.Operation, op.Summary)
+			if op.Undone() {
+				line += "  ↩️ undone"
+			}
+			fmt.Println(line)
+		}
+	},
+}
+
+// journalTable --output용 작업 기록
+type journalTable []models.JournalEntry
+
+func (t journalTable) Header() []string {
+	return []string{"id", "operation", "summary", "entries", "created_at", "undone_at"}
+}
+
+func (t journalTable) Rows() [][]string {
+	rows := make([][]string, len(t))
+	for i, op := range t {
+		undone := ""
+		if op.Undone() {
+			undone = op.UndoneAt.Format(time.RFC3339)
+		}
+		rows[i] = []string{
+			strconv.Itoa(op.ID), string(op.Operation), op.Summary, strconv.Itoa(op.Entries),
+			op.CreatedAt.Format(time.RFC3339), undone,
+		}
+	}
+	return rows
+}
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().Int("limit", 20, "Number of operations to show (0 = all)")
+}
@@ -0,0 +1,187 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/transfer"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import entries from a CSV, JSON or JSON Lines file",
+	Long: `Imports entries from a file produced by 'morama export' or written by hand.
+Columns are matched by name: title, type, rating, comment, review, date_watched, created_at,
+tags, status, season, episodes_watched and total_episodes.
+All rows are validated first and written in a single transaction, so either every
+new row is imported or none is. Rows with the same title, type and watch date as an
+existing entry are skipped, which makes re-importing the same file safe.
+
+Letterboxd diary.csv and ratings.csv files are read with --format letterboxd
+(detected automatically from the header when --format is not given):
+Name, Rating, Watched Date and Review become movie entries, with 0.5–5 stars
+converted to your configured rating scale.
+
+Examples:
+  morama import morama.csv
+  morama import backup.json --dry-run
+  morama import entries.txt --format jsonl
+  morama import diary.csv --format letterboxd`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("import", args, time.Since(startTime))
+		}()
+
+		path := args[0]
+		formatStr, _ := cmd.Flags().GetString("format")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		explicit := cmd.Flags().Changed("format")
+		format, err := resolveFormat(formatStr, path, explicit)
+		if err != nil {
+			utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid import format")
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			utils.HandleError(
+				utils.UserInputError(fmt.Sprintf("Failed to open %s", path), err),
+				"Import file error",
+			)
+		}
+		defer file.Close()
+
+		// 형식을 지정하지 않은 CSV가 Letterboxd 내보내기면 letterboxd로 읽음
+		input := bufio.NewReader(file)
+		if !explicit && format == transfer.FormatCSV && transfer.IsLetterboxdCSV(input) {
+			format = transfer.FormatLetterboxd
+			fmt.Println("📎 Detected a Letterboxd export; reading it as --format letterboxd.")
+		}
+
+		rows, err := transfer.ReadRows(input, format, transfer.Options{
+			Scale: ratingScale(),
+		})
+		if err != nil {
+			utils.HandleError(
+				utils.ValidationError(fmt.Sprintf("Failed to read %s: %v", path, err), err),
+				"Import parse error",
+			)
+		}
+
+		// 유효성 검사 에러는 한꺼번에 보고
+		invalid := 0
+		for _, row := range rows {
+			if row.Err != nil {
+				invalid++
+				fmt.Fprintf(os.Stderr, "⚠️ %s\n", row.Err.Message)
+			}
+		}
+		if invalid > 0 {
+			utils.HandleError(
+				utils.ValidationError(fmt.Sprintf("%d of %d rows are invalid; nothing was imported", invalid, len(rows)), nil),
+				"Import validation error",
+			)
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		toImport, duplicates, err := filterDuplicates(store, rows)
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to check for duplicates", err),
+				"Duplicate detection error",
+			)
+		}
+
+		if dryRun {
+			for _, entry := range toImport {
+				fmt.Printf("➕ %s (%s) %s\n", entry.Title, entry.Type, formatImportDate(entry.DateWatched))
+			}
+			fmt.Printf("🔎 Dry run: %d to import, %d duplicates skipped. Nothing was written.\n",
+				len(toImport), duplicates)
+			return
+		}
+
+		imported, err := store.ImportEntries(toImport)
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to import entries; nothing was imported", err),
+				"Import error",
+			)
+		}
+
+		utils.LogUserAction("entries_imported",
+			fmt.Sprintf("file: %s, imported: %d, duplicates: %d", path, imported, duplicates))
+		fmt.Printf("✅ Imported %d entries (%d duplicates skipped).\n", imported, duplicates)
+	},
+}
+
+// 같은 제목, 타입, 시청일의 기존 항목(또는 파일 내 앞선 행)은 중복으로 제외
+func filterDuplicates(store *storage.Storage, rows []transfer.Row) ([]models.MediaEntry, int, error) {
+	type titleKey struct {
+		title     string
+		mediaType models.MediaType
+	}
+
+	existing := make(map[titleKey]map[string]bool)
+	var entries []models.MediaEntry
+	duplicates := 0
+
+	for _, row := range rows {
+		entry := row.Entry
+		key := titleKey{entry.Title, entry.Type}
+
+		dates, ok := existing[key]
+		if !ok {
+			dates = make(map[string]bool)
+			found, err := store.FindAllByTitleAndType(entry.Title, entry.Type)
+			if err != nil && !errors.Is(err, storage.ErrNotFound) {
+				return nil, 0, err
+			}
+			for _, e := range found {
+				dates[formatImportDate(e.DateWatched)] = true
+			}
+			existing[key] = dates
+		}
+
+		date := formatImportDate(entry.DateWatched)
+		if dates[date] {
+			duplicates++
+			continue
+		}
+		dates[date] = true
+		entries = append(entries, entry)
+	}
+
+	return entries, duplicates, nil
+}
+
+// 시청일이 없으면 오늘 날짜로 저장됨
+func formatImportDate(t time.Time) string {
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t.Format("2006-01-02")
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringP("format", "f", "csv", "Input format: csv, json, jsonl or letterboxd (default: inferred from extension)")
+	importCmd.Flags().Bool("dry-run", false, "Validate and report without writing anything")
+}
@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// 프롬프트를 띄울 수 있는지 (--no-input이 아니고 stdin이 터미널일 때)
+func canPrompt(cmd *cobra.Command) bool {
+	noInput, _ := cmd.Flags().GetBool("no-input")
+	return !noInput && term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// ratingInput --rating 값 또는 프롬프트 입력으로 평점 결정 (설정된 척도로 입력받아 정규화 평점 반환)
+// current가 nil이 아니면(edit) 비대화형 모드에서 기존 값을 유지
+func ratingInput(cmd *cobra.Command, current *float64) (float64, error) {
+	scale := ratingScale()
+	if cmd.Flags().Changed("rating") {
+		ratingStr, _ := cmd.Flags().GetString("rating")
+		rating, err := scale.Parse(ratingStr)
+		if err != nil {
+			return 0, utils.ValidationError(fmt.Sprintf("Invalid --rating %q: %v", ratingStr, err), err)
+		}
+		return rating, nil
+	}
+
+	if !canPrompt(cmd) {
+		if current != nil {
+			return *current, nil
+		}
+		return 0, utils.UserInputError("--rating is required when input is not interactive", nil)
+	}
+
+	ratingPrompt := promptui.Prompt{
+		Label:    fmt.Sprintf("Rate (%s)", scale.Hint()),
+		Validate: validateRating,
+	}
+	if current != nil {
+		ratingPrompt.Default = scale.Input(*current)
+	}
+
+	ratingStr, err := ratingPrompt.Run()
+	if err != nil {
+		return 0, utils.UserInputError("Failed to get rating input", err)
+	}
+
+	rating, _ := scale.Parse(ratingStr)
+	return rating, nil
+}
+
+// commentInput --comment 값(- 이면 stdin) 또는 프롬프트 입력으로 한줄평 결정
+// 비대화형 모드에서 지정하지 않으면 current 유지
+func commentInput(cmd *cobra.Command, label, current string) (string, error) {
+	if cmd.Flags().Changed("comment") {
+		comment, _ := cmd.Flags().GetString("comment")
+		if comment != "-" {
+			return comment, nil
+		}
+
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", utils.UserInputError("Failed to read comment from stdin", err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	if !canPrompt(cmd) {
+		return current, nil
+	}
+
+	commentPrompt := promptui.Prompt{
+		Label:   label,
+		Default: current,
+	}
+
+	comment, err := commentPrompt.Run()
+	if err != nil {
+		return "", utils.UserInputError("Failed to get comment input", err)
+	}
+	return comment, nil
+}
+
+// reviewInput --review(편집기) 또는 --review-file(- 이면 stdin) 값으로 감상문 결정
+// 둘 다 지정하지 않으면 current 유지
+func reviewInput(cmd *cobra.Command, title, current string) (string, error) {
+	if cmd.Flags().Changed("review-file") {
+		path, _ := cmd.Flags().GetString("review-file")
+		if path == "-" {
+			if comment, _ := cmd.Flags().GetString("comment"); comment == "-" {
+				return "", utils.ValidationError("--comment and --review-file cannot both read from stdin", nil)
+			}
+			data, err := io.ReadAll(os.Stdin)
+			if err != nil {
+				return "", utils.UserInputError("Failed to read review from stdin", err)
+			}
+			return strings.TrimSpace(string(data)), nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", utils.SystemError(fmt.Sprintf("Failed to read review file %s", path), err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+
+	if review, _ := cmd.Flags().GetBool("review"); !review {
+		return current, nil
+	}
+	if !canPrompt(cmd) {
+		return "", utils.UserInputError("--review opens an editor and needs an interactive terminal; use --review-file instead", nil)
+	}
+	return editReview(title, current)
+}
+
+// confirmAction --yes면 바로 진행하고, 아니면 y/N 프롬프트로 확인 (거절하면 false)
+// 프롬프트를 띄울 수 없으면 --yes를 요구하는 에러
+func confirmAction(cmd *cobra.Command, label string) (bool, error) {
+	if yes, _ := cmd.Flags().GetBool("yes"); yes {
+		return true, nil
+	}
+	if !canPrompt(cmd) {
+		return false, utils.UserInputError("This needs confirmation; pass --yes when input is not interactive", nil)
+	}
+
+	prompt := promptui.Prompt{Label: label, IsConfirm: true}
+	if _, err := prompt.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return false, nil
+		}
+		return false, utils.UserInputError("Failed to get confirmation", err)
+	}
+	return true, nil
+}
+
+// 감상문 임시 파일 맨 위의 안내 (저장 후 제거)
+const reviewTemplateHeader = "<!-- Write your review of %s in Markdown. Save and close the editor to finish; leave it empty to remove the review. -->\n\n"
+
+// editReview $VISUAL/$EDITOR로 임시 Markdown 파일을 열어 감상문을 작성
+func editReview(title, current string) (string, error) {
+	file, err := os.CreateTemp("", "morama-review-*.md")
+	if err != nil {
+		return "", utils.SystemError("Failed to create a temporary review file", err)
+	}
+	path := file.Name()
+	defer os.Remove(path)
+
+	header := fmt.Sprintf(reviewTemplateHeader, title)
+	_, err = file.WriteString(header + current)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", utils.SystemError("Failed to write the temporary review file", err)
+	}
+
+	editor := editorCommand()
+	editorCmd := exec.Command(editor[0], append(editor[1:], path)...)
+	editorCmd.Stdin, editorCmd.Stdout, editorCmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := editorCmd.Run(); err != nil {
+		return "", utils.UserInputError(fmt.Sprintf("Editor %q failed; the review was not saved", strings.Join(editor, " ")), err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", utils.SystemError("Failed to read the temporary review file", err)
+	}
+	review := strings.TrimPrefix(strings.TrimLeft(string(data), "\ufeff"), strings.TrimSpace(header))
+	return strings.TrimSpace(review), nil
+}
+
+// 편집기 명령 ($VISUAL, $EDITOR 순, 없으면 vi / Windows는 notepad)
+// "code --wait"처럼 인자가 붙은 값도 허용
+func editorCommand() []string {
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if fields := strings.Fields(os.Getenv(name)); len(fields) > 0 {
+			return fields
+		}
+	}
+	if runtime.GOOS == "windows" {
+		return []string{"notepad"}
+	}
+	return []string{"vi"}
+}
+
+// add/edit 공통 입력 플래그
+func addEntryInputFlags(cmd *cobra.Command) {
+	cmd.Flags().String("rating", "", "Rating on the configured scale, e.g. 4.5, 87 or up (skips the interactive prompt)")
+	cmd.Flags().String("comment", "", "One-line review, or - to read it from stdin (skips the prompt)")
+	cmd.Flags().Bool("review", false, "Write a long-form Markdown review in $VISUAL/$EDITOR")
+	cmd.Flags().String("review-file", "", "Read the long-form review from a file, or - for stdin")
+	cmd.Flags().StringArray("tag", nil, "Genre or tag (repeatable, or comma-separated)")
+}
+
+// --tag 값을 태그 목록으로 변환 (changed: 플래그를 지정했는지)
+func tagsInput(cmd *cobra.Command) (tags []string, changed bool) {
+	values, _ := cmd.Flags().GetStringArray("tag")
+	return utils.ParseTags(values), cmd.Flags().Changed("tag")
+}
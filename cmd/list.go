@@ -1,38 +1,26 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/render"
 	"github.com/kiku99/morama/internal/storage"
 	"github.com/kiku99/morama/internal/utils"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
-// 컬럼 비율 및 최소/최대 폭 정의
+// 터미널 폭 최소/최대 (컬럼 비율은 render.CalculateTableWidths)
 const (
 	minTerminalWidth = 80
 	maxTerminalWidth = 200
-
-	// 컬럼 비율 (합계 1.0)
-	idRatio      = 0.06 // 6%
-	titleRatio   = 0.35 // 35%
-	typeRatio    = 0.08 // 8%
-	ratingRatio  = 0.10 // 10%
-	dateRatio    = 0.16 // 16%
-	commentRatio = 0.25 // 25%
-
-	// 최소 폭
-	minIdWidth      = 4
-	minTitleWidth   = 20
-	minTypeWidth    = 6
-	minRatingWidth  = 8
-	minDateWidth    = 13
-	minCommentWidth = 15
 )
 
 // 터미널 폭 감지
@@ -55,39 +43,30 @@ func getTerminalWidth() int {
 }
 
 // 동적 컬럼 폭 계산
-type tableWidths struct {
-	id        int
-	title     int
-	entryType int
-	rating    int
-	date      int
-	comment   int
-	total     int
-}
-
-func calculateTableWidths() tableWidths {
-	termWidth := getTerminalWidth()
-
-	// 테이블 경계선과 구분자를 위한 여백 (┃ 문자들)
-	borderSpace := 7 // │ 문자 7개
-
-	// 실제 내용을 위한 폭
-	contentWidth := termWidth - borderSpace
-
-	return tableWidths{
-		id:        utils.MaxInt(int(float64(contentWidth)*idRatio), minIdWidth),
-		title:     utils.MaxInt(int(float64(contentWidth)*titleRatio), minTitleWidth),
-		entryType: utils.MaxInt(int(float64(contentWidth)*typeRatio), minTypeWidth),
-		rating:    utils.MaxInt(int(float64(contentWidth)*ratingRatio), minRatingWidth),
-		date:      utils.MaxInt(int(float64(contentWidth)*dateRatio), minDateWidth),
-		comment:   utils.MaxInt(int(float64(contentWidth)*commentRatio), minCommentWidth),
-	}
+func calculateTableWidths() render.TableWidths {
+	return render.CalculateTableWidths(getTerminalWidth())
 }
 
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all movies and dramas",
-	Long:  "Display all recorded movies and dramas in a formatted table",
+	Long: `Display recorded movies and dramas in a formatted table, grouped by the year
+they were watched. Filters can be combined; sorting by title or rating prints a
+single table instead of yearly groups. --collapse shows each title once, with its
+latest viewing and how many times it was watched.
+
+Examples:
+  morama list
+  morama list --type drama --year 2024
+  morama list --tag thriller --tag korean
+  morama list --watching
+  morama list --status dropped
+  morama list --from 2024-01-01 --to 2024-06-30
+  morama list --min-rating 4 --sort rating
+  morama list --sort title --reverse --limit 20 --offset 20
+  morama list --collapse
+  morama list --year 2024 --output json | jq '.[].title'`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		startTime := time.Now()
 		defer func() {
@@ -96,6 +75,11 @@ var listCmd = &cobra.Command{
 
 		utils.LogUserAction("list_entries", "requested")
 
+		query, err := listQueryFromFlags(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid list filter")
+		}
+
 		store, err := storage.NewStorage()
 		if err != nil {
 			utils.HandleError(
@@ -105,17 +89,44 @@ var listCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		// Get all years
-		years, err := store.GetYears()
+		// 다시 본 작품을 한 줄로 접으면 페이지는 작품 단위로 나눔
+		collapse, _ := cmd.Flags().GetBool("collapse")
+		limit, offset := query.Limit, query.Offset
+		if collapse {
+			query.Limit, query.Offset = 0, 0
+		}
+
+		entries, err := store.FindEntries(query)
 		if err != nil {
 			utils.HandleError(
-				utils.DatabaseError("Failed to retrieve years", err),
-				"Year retrieval error",
+				utils.DatabaseError("Failed to retrieve entries", err),
+				"Entry retrieval error",
 			)
 		}
 
-		if len(years) == 0 {
-			fmt.Println("📭 No entries found. Add some movies or dramas with 'morama add'!")
+		if collapse {
+			all := models.GroupViewings(entries)
+			histories := paginate(all, limit, offset)
+			if renderOutput(cmd, historyTable{Items: histories, Scale: ratingScale()}) {
+				return
+			}
+			printCollapsedList(cmd, histories, query.Sort)
+			if len(histories) > 0 && (limit > 0 || offset > 0) {
+				fmt.Printf("\nShowing %d–%d of %d titles\n", offset+1, offset+len(histories), len(all))
+			}
+			return
+		}
+
+		if renderOutput(cmd, entriesOutput(entries)) {
+			return
+		}
+
+		if len(entries) == 0 {
+			if isFiltered(cmd) {
+				fmt.Println("📭 No entries match the given filters.")
+			} else {
+				fmt.Println("📭 No entries found. Add something you watched with 'morama add'!")
+			}
 			utils.LogUserAction("list_empty", "no entries found")
 			return
 		}
@@ -123,76 +134,360 @@ var listCmd = &cobra.Command{
 		// 동적 폭 계산
 		widths := calculateTableWidths()
 
-		// Display each year group
-		for _, year := range years {
-			entries, err := store.GetEntriesByYear(year)
-			if err != nil {
-				utils.Error("Failed to get entries for year %d: %v", year, err)
-				continue
+		if watching, _ := cmd.Flags().GetBool("watching"); watching {
+			fmt.Printf("\n                                                   Currently watching\n")
+			printWatchingTable(entries, widths)
+		} else if query.Sort == storage.SortDate {
+			// Display each year group
+			for _, group := range groupByYear(entries) {
+				fmt.Printf("\n                                                   Watched in %d\n", group.year)
+				printEntryTable(group.entries, widths)
 			}
+		} else {
+			fmt.Printf("\n                                                   Sorted by %s\n", query.Sort)
+			printEntryTable(entries, widths)
+		}
 
-			if len(entries) == 0 {
-				continue
+		if query.Limit > 0 || query.Offset > 0 {
+			total, err := store.CountEntries(query)
+			if err == nil {
+				fmt.Printf("\nShowing %d–%d of %d entries\n", query.Offset+1, query.Offset+len(entries), total)
 			}
+		}
 
-			fmt.Printf("\n                                                   Watched in %d\n", year)
-
-			// Print table header with calculated widths
-			fmt.Printf("┏%s┳%s┳%s┳%s┳%s┳%s┓\n",
-				strings.Repeat("━", widths.id),
-				strings.Repeat("━", widths.title),
-				strings.Repeat("━", widths.entryType),
-				strings.Repeat("━", widths.rating),
-				strings.Repeat("━", widths.date),
-				strings.Repeat("━", widths.comment))
-
-			fmt.Printf("┃%s┃%s┃%s┃%s┃%s┃%s┃\n",
-				utils.PadStringToWidth("ID", widths.id),
-				utils.PadStringToWidth("Title", widths.title),
-				utils.PadStringToWidth("Type", widths.entryType),
-				utils.PadStringToWidth("Rating", widths.rating),
-				utils.PadStringToWidth("Date Watched", widths.date),
-				utils.PadStringToWidth("Comment", widths.comment))
-
-			fmt.Printf("┡%s╇%s╇%s╇%s╇%s╇%s┩\n",
-				strings.Repeat("━", widths.id),
-				strings.Repeat("━", widths.title),
-				strings.Repeat("━", widths.entryType),
-				strings.Repeat("━", widths.rating),
-				strings.Repeat("━", widths.date),
-				strings.Repeat("━", widths.comment))
-
-			config := config.GetConfig()
-			for _, entry := range entries {
-				id := fmt.Sprintf("%d", entry.ID)
-				title := utils.TruncateStringWithWidth(entry.Title, widths.title)
-				entryType := string(entry.Type)
-				rating := fmt.Sprintf("%.1f", entry.Rating)
-				dateStr := entry.DateWatched.Format(config.Display.DateFormat)
-				comment := utils.TruncateStringWithWidth(entry.Comment, widths.comment)
-
-				fmt.Printf("│%s│%s│%s│%s│%s│%s│\n",
-					utils.PadStringToWidth(id, widths.id),
-					utils.PadStringToWidth(title, widths.title),
-					utils.PadStringToWidth(entryType, widths.entryType),
-					utils.PadStringToWidth(rating, widths.rating),
-					utils.PadStringToWidth(dateStr, widths.date),
-					utils.PadStringToWidth(comment, widths.comment))
-			}
+		utils.LogUserAction("list_completed", fmt.Sprintf("displayed %d entries", len(entries)))
+	},
+}
 
-			fmt.Printf("└%s┴%s┴%s┴%s┴%s┴%s┘\n",
-				strings.Repeat("─", widths.id),
-				strings.Repeat("─", widths.title),
-				strings.Repeat("─", widths.entryType),
-				strings.Repeat("─", widths.rating),
-				strings.Repeat("─", widths.date),
-				strings.Repeat("─", widths.comment))
+// 목록 필터/정렬 플래그 → storage.Query
+func listQueryFromFlags(cmd *cobra.Command) (storage.Query, error) {
+	flags := cmd.Flags()
+	var query storage.Query
+
+	mediaType, err := optionalMediaType(cmd)
+	if err != nil {
+		return query, err
+	}
+	query.Type = mediaType
+
+	query.Year, _ = flags.GetInt("year")
+
+	tags, _ := flags.GetStringArray("tag")
+	query.Tags = utils.ParseTags(tags)
+
+	if statusStr, _ := flags.GetString("status"); statusStr != "" {
+		status, err := models.ParseStatus(statusStr)
+		if err != nil {
+			return query, utils.ValidationError(err.Error(), err)
 		}
+		query.Status = status
+	}
+	if watching, _ := flags.GetBool("watching"); watching {
+		if query.Status != "" && query.Status != models.StatusWatching {
+			return query, utils.ValidationError("--watching cannot be combined with --status "+string(query.Status), nil)
+		}
+		query.Status = models.StatusWatching
+	}
 
-		utils.LogUserAction("list_completed", fmt.Sprintf("displayed %d years", len(years)))
-	},
+	now := time.Now()
+	for _, name := range []string{"from", "to"} {
+		value, _ := flags.GetString(name)
+		if value == "" {
+			continue
+		}
+		date, err := utils.ParseDate(value, now)
+		if err != nil {
+			return query, utils.ValidationError(fmt.Sprintf("Invalid --%s: %v", name, err), err)
+		}
+		if name == "from" {
+			query.From = date
+		} else {
+			query.To = date
+		}
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return query, utils.ValidationError("--to must not be before --from", nil)
+	}
+
+	// 평점 범위는 설정된 척도로 받아 정규화 평점으로 비교
+	scale := ratingScale()
+	for _, name := range []string{"min-rating", "max-rating"} {
+		if !flags.Changed(name) {
+			continue
+		}
+		value, _ := flags.GetFloat64(name)
+		rating, err := scale.Normalize(value)
+		if err != nil {
+			return query, utils.ValidationError(fmt.Sprintf("Invalid --%s: %v", name, err), err)
+		}
+		if name == "min-rating" {
+			query.MinRating = &rating
+		} else {
+			query.MaxRating = &rating
+		}
+	}
+	if query.MinRating != nil && query.MaxRating != nil && *query.MinRating > *query.MaxRating {
+		return query, utils.ValidationError("--min-rating must not be greater than --max-rating", nil)
+	}
+
+	sortStr, _ := flags.GetString("sort")
+	if watching, _ := flags.GetBool("watching"); watching && !flags.Changed("sort") {
+		sortStr = string(storage.SortUpdated) // 최근에 본 작품 먼저
+	}
+	sortField, err := storage.ParseSortField(sortStr)
+	if err != nil {
+		return query, utils.ValidationError(err.Error(), err)
+	}
+	query.Sort = sortField
+	query.Reverse, _ = flags.GetBool("reverse")
+
+	query.Limit, _ = flags.GetInt("limit")
+	query.Offset, _ = flags.GetInt("offset")
+	if query.Limit < 0 || query.Offset < 0 {
+		return query, utils.ValidationError("--limit and --offset must not be negative", nil)
+	}
+
+	return query, nil
+}
+
+// limit/offset 적용 (limit이 0이면 제한 없음)
+func paginate(histories []models.TitleHistory, limit, offset int) []models.TitleHistory {
+	if offset >= len(histories) {
+		return nil
+	}
+	histories = histories[offset:]
+	if limit > 0 && limit < len(histories) {
+		histories = histories[:limit]
+	}
+	return histories
+}
+
+// printCollapsedList 작품마다 가장 최근 시청 기록 한 줄 (다시 본 횟수는 제목 뒤에 ×N)
+func printCollapsedList(cmd *cobra.Command, histories []models.TitleHistory, sortField storage.SortField) {
+	if len(histories) == 0 {
+		if isFiltered(cmd) {
+			fmt.Println("📭 No entries match the given filters.")
+		} else {
+			fmt.Println("📭 No entries found. Add something you watched with 'morama add'!")
+		}
+		return
+	}
+
+	latest := make([]models.MediaEntry, len(histories))
+	for i, history := range histories {
+		latest[i] = history.Latest()
+		if n := len(history.Viewings); n > 1 {
+			latest[i].Title = fmt.Sprintf("%s ×%d", history.Title, n)
+		}
+	}
+
+	widths := calculateTableWidths()
+	if sortField == storage.SortDate {
+		for _, group := range groupByYear(latest) {
+			fmt.Printf("\n                                                   Last watched in %d\n", group.year)
+			printEntryTable(group.entries, widths)
+		}
+		return
+	}
+	fmt.Printf("\n                                                   Sorted by %s\n", sortField)
+	printEntryTable(latest, widths)
+}
+
+// historyTable --collapse --output용 작품 목록 (JSON/YAML은 시청 기록 포함, 평점은 척도 값)
+type historyTable struct {
+	Items []models.TitleHistory
+	Scale models.RatingScale
+}
+
+func (t historyTable) Header() []string {
+	return []string{"title", "type", "viewings", "first_watched", "last_watched", "latest_rating", "avg_rating"}
+}
+
+func (t historyTable) Rows() [][]string {
+	rows := make([][]string, len(t.Items))
+	for i, history := range t.Items {
+		latest := history.Latest()
+		rows[i] = []string{
+			history.Title, string(history.Type), strconv.Itoa(len(history.Viewings)),
+			history.FirstWatched().Format(time.RFC3339), latest.DateWatched.Format(time.RFC3339),
+			strconv.FormatFloat(t.Scale.Value(latest.Rating), 'f', -1, 64),
+			strconv.FormatFloat(t.Scale.Average(history.AvgRating()), 'f', -1, 64),
+		}
+	}
+	return rows
+}
+
+func (t historyTable) scaled() []models.TitleHistory {
+	scaled := make([]models.TitleHistory, len(t.Items))
+	for i, history := range t.Items {
+		history.Viewings = t.Scale.ScaleEntries(history.Viewings)
+		scaled[i] = history
+	}
+	return scaled
+}
+
+// JSON 출력 시 빈 목록도 null 대신 []로
+func (t historyTable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.scaled())
+}
+
+func (t historyTable) MarshalYAML() (interface{}, error) {
+	return t.scaled(), nil
+}
+
+// 필터 플래그가 하나라도 지정됐는지
+func isFiltered(cmd *cobra.Command) bool {
+	for _, name := range []string{"type", "year", "tag", "status", "watching", "from", "to", "min-rating", "max-rating", "offset"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+type yearGroup struct {
+	year    int
+	entries []models.MediaEntry
+}
+
+// 이미 정렬된 항목을 시청 연도별로 묶음 (순서 유지)
+func groupByYear(entries []models.MediaEntry) []yearGroup {
+	var groups []yearGroup
+	for _, entry := range entries {
+		year := entry.DateWatched.Year()
+		if len(groups) == 0 || groups[len(groups)-1].year != year {
+			groups = append(groups, yearGroup{year: year})
+		}
+		last := &groups[len(groups)-1]
+		last.entries = append(last.entries, entry)
+	}
+	return groups
+}
+
+func printEntryTable(entries []models.MediaEntry, widths render.TableWidths) {
+	// Print table header with calculated widths
+	fmt.Printf("┏%s┳%s┳%s┳%s┳%s┳%s┓\n",
+		strings.Repeat("━", widths.ID),
+		strings.Repeat("━", widths.Title),
+		strings.Repeat("━", widths.Type),
+		strings.Repeat("━", widths.Rating),
+		strings.Repeat("━", widths.Date),
+		strings.Repeat("━", widths.Comment))
+
+	fmt.Printf("┃%s┃%s┃%s┃%s┃%s┃%s┃\n",
+		utils.PadStringToWidth("ID", widths.ID),
+		utils.PadStringToWidth("Title", widths.Title),
+		utils.PadStringToWidth("Type", widths.Type),
+		utils.PadStringToWidth("Rating", widths.Rating),
+		utils.PadStringToWidth("Date Watched", widths.Date),
+		utils.PadStringToWidth("Comment", widths.Comment))
+
+	fmt.Printf("┡%s╇%s╇%s╇%s╇%s╇%s┩\n",
+		strings.Repeat("━", widths.ID),
+		strings.Repeat("━", widths.Title),
+		strings.Repeat("━", widths.Type),
+		strings.Repeat("━", widths.Rating),
+		strings.Repeat("━", widths.Date),
+		strings.Repeat("━", widths.Comment))
+
+	config := config.GetConfig()
+	for _, entry := range entries {
+		id := fmt.Sprintf("%d", entry.ID)
+		title := utils.TruncateStringWithWidth(entry.Title, widths.Title)
+		entryType := string(entry.Type)
+		rating := formatRating(entry.Rating)
+		dateStr := entry.DateWatched.Format(config.Display.DateFormat)
+		comment := utils.TruncateStringWithWidth(entry.Comment, widths.Comment)
+
+		fmt.Printf("│%s│%s│%s│%s│%s│%s│\n",
+			utils.PadStringToWidth(id, widths.ID),
+			utils.PadStringToWidth(title, widths.Title),
+			utils.PadStringToWidth(entryType, widths.Type),
+			utils.PadStringToWidth(rating, widths.Rating),
+			utils.PadStringToWidth(dateStr, widths.Date),
+			utils.PadStringToWidth(comment, widths.Comment))
+	}
+
+	fmt.Printf("└%s┴%s┴%s┴%s┴%s┴%s┘\n",
+		strings.Repeat("─", widths.ID),
+		strings.Repeat("─", widths.Title),
+		strings.Repeat("─", widths.Type),
+		strings.Repeat("─", widths.Rating),
+		strings.Repeat("─", widths.Date),
+		strings.Repeat("─", widths.Comment))
+}
+
+// printWatchingTable 보고 있는 작품의 시즌/회차 진행 상황 표
+// Rating/Comment 대신 Progress/Last Watched 컬럼을 보여줌
+func printWatchingTable(entries []models.MediaEntry, widths render.TableWidths) {
+	progressWidth := widths.Rating + widths.Comment
+	fmt.Printf("┏%s┳%s┳%s┳%s┳%s┓\n",
+		strings.Repeat("━", widths.ID),
+		strings.Repeat("━", widths.Title),
+		strings.Repeat("━", widths.Type),
+		strings.Repeat("━", progressWidth),
+		strings.Repeat("━", widths.Date))
+
+	fmt.Printf("┃%s┃%s┃%s┃%s┃%s┃\n",
+		utils.PadStringToWidth("ID", widths.ID),
+		utils.PadStringToWidth("Title", widths.Title),
+		utils.PadStringToWidth("Type", widths.Type),
+		utils.PadStringToWidth("Progress", progressWidth),
+		utils.PadStringToWidth("Last Watched", widths.Date))
+
+	fmt.Printf("┡%s╇%s╇%s╇%s╇%s┩\n",
+		strings.Repeat("━", widths.ID),
+		strings.Repeat("━", widths.Title),
+		strings.Repeat("━", widths.Type),
+		strings.Repeat("━", progressWidth),
+		strings.Repeat("━", widths.Date))
+
+	config := config.GetConfig()
+	for _, entry := range entries {
+		progress := entry.Progress()
+		if entry.TotalEpisodes > 0 {
+			progress = fmt.Sprintf("%s %s", progressBar(entry.EpisodesWatched, entry.TotalEpisodes, 10), progress)
+		}
+
+		fmt.Printf("│%s│%s│%s│%s│%s│\n",
+			utils.PadStringToWidth(fmt.Sprintf("%d", entry.ID), widths.ID),
+			utils.PadStringToWidth(utils.TruncateStringWithWidth(entry.Title, widths.Title), widths.Title),
+			utils.PadStringToWidth(string(entry.Type), widths.Type),
+			utils.PadStringToWidth(utils.TruncateStringWithWidth(progress, progressWidth), progressWidth),
+			utils.PadStringToWidth(entry.UpdatedAt.Format(config.Display.DateFormat), widths.Date))
+	}
+
+	fmt.Printf("└%s┴%s┴%s┴%s┴%s┘\n",
+		strings.Repeat("─", widths.ID),
+		strings.Repeat("─", widths.Title),
+		strings.Repeat("─", widths.Type),
+		strings.Repeat("─", progressWidth),
+		strings.Repeat("─", widths.Date))
+}
+
+// ▓▓▓░░ 형태의 진행 막대 (범위를 벗어난 값은 비었거나 꽉 찬 막대로)
+func progressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = utils.MinInt(utils.MaxInt(done*width/total, 0), width)
+	}
+	return strings.Repeat("▓", filled) + strings.Repeat("░", width-filled)
 }
 
 func init() {
 	rootCmd.AddCommand(listCmd)
+	addTypeFlag(listCmd, "Only show this media type", false)
+	listCmd.Flags().Int("year", 0, "Only show entries watched in this year")
+	listCmd.Flags().StringArray("tag", nil, "Only show entries with this tag (repeatable; all must match)")
+	listCmd.Flags().String("status", "", "Only show entries with this status (watching, completed, dropped, on-hold)")
+	listCmd.Flags().Bool("watching", false, "Show what you are currently watching, with episode progress")
+	listCmd.Flags().String("from", "", "Only show entries watched on or after this date")
+	listCmd.Flags().String("to", "", "Only show entries watched on or before this date")
+	listCmd.Flags().Float64("min-rating", 0, "Minimum rating on the configured scale")
+	listCmd.Flags().Float64("max-rating", 0, "Maximum rating on the configured scale")
+	listCmd.Flags().String("sort", "date", "Sort by title, rating, date or updated")
+	listCmd.Flags().Bool("reverse", false, "Reverse the sort order")
+	listCmd.Flags().Int("limit", 0, "Maximum number of entries to show")
+	listCmd.Flags().Int("offset", 0, "Number of entries to skip")
+	listCmd.Flags().Bool("collapse", false, "Show each title once with its latest viewing and watch count")
 }
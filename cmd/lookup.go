@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+)
+
+// 제안할 비슷한 제목 수
+const maxTitleSuggestions = 3
+
+// findEntriesByTitle 제목으로 항목 조회 (mediaType이 비어 있으면 모든 타입)
+// 정확히 일치하는 항목이 없고 search.fuzzy_match가 켜져 있으면
+// 정규화 일치(대소문자/전각/자모 무시) 또는 부분 일치로 찾고,
+// 그래도 없으면 비슷한 제목을 제안하는 NotFoundError 반환
+func findEntriesByTitle(store *storage.Storage, title string, mediaType models.MediaType) ([]models.MediaEntry, error) {
+	var entries []models.MediaEntry
+	var err error
+	if mediaType == "" {
+		entries, err = store.FindAllByTitle(title)
+	} else {
+		entries, err = store.FindAllByTitleAndType(title, mediaType)
+	}
+	if err == nil {
+		return entries, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, utils.DatabaseError("Failed to search entries", err)
+	}
+
+	notFound := fmt.Sprintf("No entry found for \"%s\"", title)
+	if mediaType != "" {
+		notFound = fmt.Sprintf("No entry found for \"%s\" (%s)", title, mediaType)
+	}
+	if !config.GetConfig().Search.FuzzyMatch {
+		return nil, utils.NotFoundError(notFound, err)
+	}
+
+	candidates, err := store.FindEntries(storage.Query{Type: mediaType})
+	if err != nil {
+		return nil, utils.DatabaseError("Failed to search entries", err)
+	}
+
+	// 정규화 일치가 부분 일치보다 우선
+	var equal, partial []models.MediaEntry
+	normalized := utils.NormalizeTitle(title)
+	titles := make([]string, 0, len(candidates))
+	for _, entry := range candidates {
+		if utils.NormalizeTitle(entry.Title) == normalized {
+			equal = append(equal, entry)
+		} else if utils.TitleMatches(entry.Title, title) {
+			partial = append(partial, entry)
+		}
+		titles = append(titles, entry.Title)
+	}
+	if len(equal) > 0 {
+		return equal, nil
+	}
+	if len(partial) > 0 {
+		return partial, nil
+	}
+
+	if suggestions := utils.SuggestTitles(title, titles, maxTitleSuggestions); len(suggestions) > 0 {
+		notFound += fmt.Sprintf(". Did you mean \"%s\"?", strings.Join(suggestions, "\", \""))
+	}
+	return nil, utils.NotFoundError(notFound, nil)
+}
+
+// resolveEntryArg show/edit 인자를 ID 또는 제목으로 해석
+// 숫자 인자는 먼저 ID로 조회하고, 해당 ID가 없으면 제목(예: "1917")으로 다시 찾음
+// --type이 지정되면 항상 제목으로 취급. byID는 ID로 찾았는지 여부
+func resolveEntryArg(store *storage.Storage, arg string, mediaType models.MediaType) (entries []models.MediaEntry, byID bool, err error) {
+	id, idErr := utils.ParseID(arg)
+	if mediaType != "" || idErr != nil || id <= 0 {
+		entries, err = findEntriesByTitle(store, arg, mediaType)
+		return entries, false, err
+	}
+
+	entry, err := store.GetByID(id)
+	if err == nil {
+		return []models.MediaEntry{entry}, true, nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return nil, false, utils.DatabaseError("Failed to load entry", err)
+	}
+
+	entries, err = findEntriesByTitle(store, arg, mediaType)
+	if err != nil {
+		return nil, false, utils.NotFoundError(fmt.Sprintf("No entry found with ID %d", id), nil)
+	}
+	return entries, false, nil
+}
+
+// titleKey 같은 작품을 가리키는 제목과 타입
+type titleKey struct {
+	Title string
+	Type  models.MediaType
+}
+
+func (k titleKey) String() string {
+	return fmt.Sprintf("%s (%s)", k.Title, k.Type)
+}
+
+// 서로 다른 제목/타입 목록 (처음 나온 순서 유지)
+func distinctTitles(entries []models.MediaEntry) []titleKey {
+	seen := make(map[titleKey]bool)
+	var keys []titleKey
+	for _, entry := range entries {
+		key := titleKey{entry.Title, entry.Type}
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// 제목/타입이 일치하는 항목만 남김
+func filterByTitle(entries []models.MediaEntry, key titleKey) []models.MediaEntry {
+	var filtered []models.MediaEntry
+	for _, entry := range entries {
+		if entry.Title == key.Title && entry.Type == key.Type {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
+
+// selectTitle 여러 작품(예: 같은 제목의 영화와 드라마)이 일치할 때 하나를 고르게 함
+// 프롬프트를 띄울 수 없으면 작품별 ID를 나열한 에러 반환
+func selectTitle(cmd *cobra.Command, query string, entries []models.MediaEntry) (titleKey, error) {
+	keys := distinctTitles(entries)
+	if len(keys) == 1 {
+		return keys[0], nil
+	}
+
+	if !canPrompt(cmd) {
+		var choices []string
+		for _, key := range keys {
+			var ids []string
+			for _, entry := range filterByTitle(entries, key) {
+				ids = append(ids, fmt.Sprintf("%d", entry.ID))
+			}
+			choices = append(choices, fmt.Sprintf("%s: ID %s", key, strings.Join(ids, ", ")))
+		}
+		return titleKey{}, utils.UserInputError(
+			fmt.Sprintf("\"%s\" matches several titles; use --type or an ID (%s)",
+				query, strings.Join(choices, "; ")), nil)
+	}
+
+	items := make([]string, len(keys))
+	for i, key := range keys {
+		items[i] = key.String()
+	}
+
+	prompt := promptui.Select{
+		Label: fmt.Sprintf("Several titles match \"%s\"", query),
+		Items: items,
+	}
+	index, _, err := prompt.Run()
+	if err != nil {
+		return titleKey{}, utils.UserInputError("Failed to select a title", err)
+	}
+	return keys[index], nil
+}
+
+// selectEntry 여러 항목이 일치할 때 하나를 고르게 함
+func selectEntry(cmd *cobra.Command, label string, entries []models.MediaEntry) (*models.MediaEntry, error) {
+	if len(entries) == 1 {
+		return &entries[0], nil
+	}
+
+	items := make([]string, len(entries))
+	ids := make([]string, len(entries))
+	for i, entry := range entries {
+		items[i] = describeEntry(entry)
+		ids[i] = fmt.Sprintf("%d", entry.ID)
+	}
+
+	if !canPrompt(cmd) {
+		return nil, utils.UserInputError(
+			fmt.Sprintf("%s; specify one with --id (%s)", label, strings.Join(ids, ", ")), nil)
+	}
+
+	prompt := promptui.Select{
+		Label: label,
+		Items: items,
+	}
+	index, _, err := prompt.Run()
+	if err != nil {
+		return nil, utils.UserInputError("Failed to select an entry", err)
+	}
+	return &entries[index], nil
+}
+
+// 선택 목록용 한 줄 요약
+func describeEntry(entry models.MediaEntry) string {
+	cfg := config.GetConfig()
+	return fmt.Sprintf("[%d] %s (%s) ⭐ %s · %s",
+		entry.ID, entry.Title, entry.Type, formatRating(entry.Rating),
+		entry.DateWatched.Format(cfg.Display.DateFormat))
+}
+
+// resolveEntry ID 또는 제목으로 항목 하나를 찾음 (여러 개가 일치하면 선택)
+func resolveEntry(cmd *cobra.Command, store *storage.Storage, arg string, mediaType models.MediaType) (*models.MediaEntry, error) {
+	entries, byID, err := resolveEntryArg(store, arg, mediaType)
+	if err != nil {
+		return nil, err
+	}
+	if byID {
+		return &entries[0], nil
+	}
+
+	key, err := selectTitle(cmd, arg, entries)
+	if err != nil {
+		return nil, err
+	}
+	return selectEntry(cmd, fmt.Sprintf("Several entries match %s", key), filterByTitle(entries, key))
+}
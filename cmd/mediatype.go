@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// registerConfiguredTypes config.yaml의 types를 미디어 타입 레지스트리에 등록
+func registerConfiguredTypes(cfg *config.Config) {
+	for _, t := range cfg.Types {
+		info := models.MediaTypeInfo{
+			Name:   models.MediaType(t.Name),
+			Label:  t.Label,
+			Plural: t.Plural,
+			Icon:   t.Icon,
+		}
+		if err := models.RegisterMediaType(info); err != nil {
+			utils.Warning("Ignoring media type in config.yaml: %v", err)
+		}
+	}
+}
+
+// addTypeFlag --type 플래그와 자동 완성 등록
+// legacy가 true면 이전 버전의 --movie/--drama도 숨겨진 deprecated 플래그로 유지
+func addTypeFlag(cmd *cobra.Command, usage string, legacy bool) {
+	cmd.Flags().String("type", "", usage)
+	cmd.RegisterFlagCompletionFunc("type", completeMediaTypes)
+
+	if legacy {
+		cmd.Flags().Bool("movie", false, "Same as --type movie")
+		cmd.Flags().Bool("drama", false, "Same as --type drama")
+		cmd.Flags().MarkDeprecated("movie", "use --type movie instead")
+		cmd.Flags().MarkDeprecated("drama", "use --type drama instead")
+	}
+}
+
+func completeMediaTypes(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	var names []string
+	for _, t := range models.MediaTypes() {
+		names = append(names, fmt.Sprintf("%s\t%s", t, t.Label()))
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
+
+// optionalMediaType --type (또는 deprecated --movie/--drama) 값, 지정하지 않으면 "" (전체)
+func optionalMediaType(cmd *cobra.Command) (models.MediaType, error) {
+	var selected []models.MediaType
+
+	if typeStr, _ := cmd.Flags().GetString("type"); typeStr != "" {
+		mediaType, err := models.ParseMediaType(typeStr)
+		if err != nil {
+			return "", utils.ValidationError(err.Error(), err)
+		}
+		selected = append(selected, mediaType)
+	}
+	for _, legacy := range []models.MediaType{models.Movie, models.Drama} {
+		if set, err := cmd.Flags().GetBool(string(legacy)); err == nil && set {
+			selected = append(selected, legacy)
+		}
+	}
+
+	switch {
+	case len(selected) > 1:
+		return "", utils.ValidationError("Specify only one media type", nil)
+	case len(selected) == 1:
+		return selected[0], nil
+	default:
+		return "", nil
+	}
+}
+
+// requiredMediaType optionalMediaType과 같지만 타입 지정이 필수
+func requiredMediaType(cmd *cobra.Command) (models.MediaType, error) {
+	mediaType, err := optionalMediaType(cmd)
+	if err == nil && mediaType == "" {
+		err = utils.ValidationError("Must specify a media type with --type (e.g. --type movie)", nil)
+	}
+	return mediaType, err
+}
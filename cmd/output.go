@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/render"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+// ratingScale 설정된 평점 척도 (display.rating_scale)
+func ratingScale() models.RatingScale {
+	return config.GetConfig().Display.RatingScale
+}
+
+// formatRating 정규화 평점을 설정된 척도로 표시
+func formatRating(rating float64) string {
+	return ratingScale().Format(rating)
+}
+
+// entriesOutput --output용 항목 목록 (평점은 설정된 척도로 출력)
+func entriesOutput(entries []models.MediaEntry) render.Entries {
+	return render.Entries{Items: entries, Scale: ratingScale()}
+}
+
+// outputFormat 전역 --output 플래그 값 (잘못된 값이면 종료)
+func outputFormat(cmd *cobra.Command) render.Format {
+	value, _ := cmd.Flags().GetString("output")
+	format, err := render.ParseFormat(value)
+	if err != nil {
+		utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid output format")
+	}
+	return format
+}
+
+// renderOutput --output이 기계 판독용 형식이면 v를 출력하고 true 반환
+// table이면 아무것도 하지 않고 false 반환 (명령이 직접 표를 출력)
+func renderOutput(cmd *cobra.Command, v interface{}) bool {
+	format := outputFormat(cmd)
+	if !format.Structured() {
+		return false
+	}
+
+	if err := render.Render(os.Stdout, format, v); err != nil {
+		utils.HandleError(utils.ValidationError(err.Error(), err), "Output rendering error")
+	}
+	return true
+}
+
+func init() {
+	rootCmd.PersistentFlags().String("output", string(render.FormatTable), "Output format: table, json, yaml, csv or tsv")
+	rootCmd.RegisterFlagCompletionFunc("output", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		formats := make([]string, len(render.Formats))
+		for i, f := range render.Formats {
+			formats[i] = string(f)
+		}
+		return formats, cobra.ShellCompDirectiveNoFileComp
+	})
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var progressCmd = &cobra.Command{
+	Use:   "progress [title|id] [+N|-N|N]",
+	Short: "Track episode and season progress",
+	Long: `Updates how far you are into a drama, anime or other episodic entry.
++N/-N moves the episode count forward or back, a bare N sets it. Without a change,
+the current progress is shown. Reaching the last episode marks the entry completed;
+any other progress marks it as watching unless --status is given.
+Put -- before a negative change so it is not read as a flag.
+
+Examples:
+  morama progress "Hospital Playlist" +1
+  morama progress 12 -- -1
+  morama progress 12 --episode 7
+  morama progress 12 --season 2 --total-episodes 12
+  morama progress 12 --status dropped`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("progress", args, time.Since(startTime))
+		}()
+
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		entry, err := resolveEntry(cmd, store, args[0], mediaType)
+		if err != nil {
+			utils.HandleError(err, "Error finding entry")
+		}
+		if !entry.Type.Info().Episodic {
+			utils.HandleError(
+				utils.ValidationError(fmt.Sprintf("%s entries have no episodes to track", entry.Type.Label()), nil),
+				"Invalid progress update",
+			)
+		}
+
+		if len(args) == 1 && !progressFlagsChanged(cmd) {
+			printProgress(cmd, entry)
+			return
+		}
+
+		var episodeArg string
+		if len(args) == 2 {
+			episodeArg = args[1]
+		}
+		if err := applyProgressFlags(cmd, entry, episodeArg); err != nil {
+			utils.HandleError(err, "Invalid progress update")
+		}
+
+		if err := store.UpdateProgress(entry.ID, *entry); err != nil {
+			utils.HandleError(entryWriteError(err, "Failed to update progress"), "Progress update error")
+		}
+
+		utils.LogUserAction("progress_updated", fmt.Sprintf("id: %d, %s (%s)", entry.ID, entry.Progress(), entry.Status))
+		printProgress(cmd, entry)
+	},
+}
+
+// +N/-N/N 인자를 현재 회차에 반영
+func applyEpisodeArg(entry *models.MediaEntry, arg string) error {
+	n, err := strconv.Atoi(arg)
+	if err != nil {
+		return utils.ValidationError(fmt.Sprintf("Invalid episode change %q (expected +N, -N or N)", arg), err)
+	}
+
+	if strings.HasPrefix(arg, "+") || strings.HasPrefix(arg, "-") {
+		n += entry.EpisodesWatched
+	}
+	return setEpisode(entry, utils.MaxInt(n, 0))
+}
+
+func setEpisode(entry *models.MediaEntry, episode int) error {
+	if entry.TotalEpisodes > 0 && episode > entry.TotalEpisodes {
+		return utils.ValidationError(
+			fmt.Sprintf("Episode %d is past the last episode (%d); use --total-episodes to change it", episode, entry.TotalEpisodes), nil)
+	}
+	entry.EpisodesWatched = episode
+	return nil
+}
+
+// --season/--total-episodes/--episode, +N/-N/N 인자(episodeArg), --status 순서로 적용
+// 다른 시즌으로 넘어가면 회차 수는 새로 셈. 상태를 지정하지 않았으면 회차로 판단
+func applyProgressFlags(cmd *cobra.Command, entry *models.MediaEntry, episodeArg string) error {
+	flags := cmd.Flags()
+	progressed := episodeArg != "" || episodeFlagsChanged(cmd)
+
+	if flags.Changed("season") {
+		season, _ := flags.GetInt("season")
+		if season < 0 {
+			return utils.ValidationError("--season must not be negative", nil)
+		}
+		if season != entry.Season {
+			entry.Season, entry.EpisodesWatched, entry.TotalEpisodes = season, 0, 0
+		}
+	}
+	if flags.Changed("total-episodes") {
+		total, _ := flags.GetInt("total-episodes")
+		if total < 0 {
+			return utils.ValidationError("--total-episodes must not be negative", nil)
+		}
+		entry.TotalEpisodes = total
+	}
+	if flags.Changed("episode") {
+		episode, _ := flags.GetInt("episode")
+		if episode < 0 {
+			return utils.ValidationError("--episode must not be negative", nil)
+		}
+		if err := setEpisode(entry, episode); err != nil {
+			return err
+		}
+	}
+	if episodeArg != "" {
+		if err := applyEpisodeArg(entry, episodeArg); err != nil {
+			return err
+		}
+	}
+	if statusStr, _ := flags.GetString("status"); statusStr != "" {
+		status, err := models.ParseStatus(statusStr)
+		if err != nil {
+			return utils.ValidationError(err.Error(), err)
+		}
+		entry.Status = status
+	} else if progressed {
+		entry.Status = models.StatusWatching
+		if entry.TotalEpisodes > 0 && entry.EpisodesWatched == entry.TotalEpisodes {
+			entry.Status = models.StatusCompleted
+		}
+	}
+	return nil
+}
+
+// 시즌/회차 플래그를 지정했는지
+func episodeFlagsChanged(cmd *cobra.Command) bool {
+	for _, name := range []string{"season", "episode", "total-episodes"} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func progressFlagsChanged(cmd *cobra.Command) bool {
+	return episodeFlagsChanged(cmd) || cmd.Flags().Changed("status")
+}
+
+func addProgressFlags(cmd *cobra.Command) {
+	cmd.Flags().String("status", "", "Watch status: watching, completed, dropped or on-hold")
+	cmd.Flags().Int("season", 0, "Current season")
+	cmd.Flags().Int("episode", 0, "Episodes watched in the current season")
+	cmd.Flags().Int("total-episodes", 0, "Number of episodes in the current season")
+	cmd.RegisterFlagCompletionFunc("status", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		statuses := make([]string, len(models.Statuses))
+		for i, s := range models.Statuses {
+			statuses[i] = string(s)
+		}
+		return statuses, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// 저장 에러를 AppError로 변환 (회차 진행 상황이 맞지 않으면 입력 오류)
+func entryWriteError(err error, message string) *utils.AppError {
+	if errors.Is(err, storage.ErrInvalidProgress) {
+		return utils.ValidationError(err.Error(), err)
+	}
+	return utils.DatabaseError(message, err)
+}
+
+// 상태별 표시 아이콘
+var statusIcons = map[models.Status]string{
+	models.StatusWatching:  "▶️",
+	models.StatusCompleted: "✅",
+	models.StatusDropped:   "⏹️",
+	models.StatusOnHold:    "⏸️",
+}
+
+func printProgress(cmd *cobra.Command, entry *models.MediaEntry) {
+	if renderOutput(cmd, entriesOutput([]models.MediaEntry{*entry})) {
+		return
+	}
+	progress := entry.Progress()
+	if progress == "" {
+		progress = "no episodes logged"
+	}
+	fmt.Printf("%s [%d] %s: %s (%s)\n", statusIcons[entry.Status], entry.ID, entry.Title, progress, entry.Status)
+}
+
+func init() {
+	rootCmd.AddCommand(progressCmd)
+	addTypeFlag(progressCmd, "Only match this media type", false)
+	addProgressFlags(progressCmd)
+}
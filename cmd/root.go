@@ -17,15 +17,16 @@ your watched movies and dramas. You can add new entries with ratings
 and comments, and view them in a beautiful table format.
 
 Examples:
-  morama add "언젠가는 슬기로울 전공의생활" --drama
-  morama add "인셉션" --movie  
+  morama add "언젠가는 슬기로울 전공의생활" --type drama
+  morama add "인셉션" --type movie
   morama list'`,
 }
 
 // Execute initializes config, logger, and runs the CLI
 func Execute() {
 	// Initialize configuration
-	config.GetConfig()
+	cfg := config.GetConfig()
+	registerConfiguredTypes(cfg)
 
 	// Initialize logger from config
 	if err := utils.InitLoggerFromConfig(); err != nil {
@@ -46,4 +47,5 @@ func Execute() {
 
 func init() {
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	rootCmd.PersistentFlags().Bool("no-input", false, "Never prompt; fail if a required value is missing")
 }
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// 터미널 출력 시 일치 부분 강조 (굵은 노란색)
+const (
+	ansiHighlightStart = "\x1b[1;33m"
+	ansiHighlightEnd   = "\x1b[0m"
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search titles and comments",
+	Long: `Full-text search over titles and one-line reviews, ranked by relevance.
+Every word must match, and each word also matches longer words that start with it.
+Case sensitivity and the default number of results come from the search section
+of ~/.morama/config.yaml.
+
+Examples:
+  morama search 인셉
+  morama search "time travel" --type movie
+  morama search dream --limit 5
+  morama search love --tag romance`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("search", args, time.Since(startTime))
+		}()
+
+		query := strings.Join(args, " ")
+		utils.LogUserAction("search", fmt.Sprintf("query: %s", query))
+
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		cfg := config.GetConfig()
+		opts := storage.SearchOptions{
+			Type:           mediaType,
+			Limit:          cfg.Search.MaxResults,
+			CaseSensitive:  cfg.Search.CaseSensitive,
+			HighlightStart: "[",
+			HighlightEnd:   "]",
+		}
+		tags, _ := cmd.Flags().GetStringArray("tag")
+		opts.Tags = utils.ParseTags(tags)
+		if cmd.Flags().Changed("limit") {
+			opts.Limit, _ = cmd.Flags().GetInt("limit")
+		}
+		if term.IsTerminal(int(os.Stdout.Fd())) {
+			opts.HighlightStart, opts.HighlightEnd = ansiHighlightStart, ansiHighlightEnd
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		results, err := store.Search(query, opts)
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to search entries", err),
+				"Search error",
+			)
+		}
+
+		entries := make([]models.MediaEntry, len(results))
+		for i, result := range results {
+			entries[i] = result.Entry
+		}
+		if renderOutput(cmd, entriesOutput(entries)) {
+			return
+		}
+
+		if len(results) == 0 {
+			fmt.Printf("🔍 No entries match \"%s\".\n", query)
+			return
+		}
+
+		fmt.Printf("🔍 %d result(s) for \"%s\"\n\n", len(results), query)
+		for _, result := range results {
+			entry := result.Entry
+			fmt.Printf("[%d] %s (%s)  ⭐ %s  🗓️ %s\n",
+				entry.ID, result.TitleMatch, entry.Type, formatRating(entry.Rating),
+				entry.DateWatched.Format(cfg.Display.DateFormat))
+			if result.CommentSnippet != "" {
+				fmt.Printf("     💬 %s\n", result.CommentSnippet)
+			}
+		}
+
+		utils.LogUserAction("search_completed", fmt.Sprintf("query: %s, results: %d", query, len(results)))
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(searchCmd)
+	searchCmd.Flags().Int("limit", 0, "Maximum number of results (default: search.max_results)")
+	addTypeFlag(searchCmd, "Only search this media type", true)
+	searchCmd.Flags().StringArray("tag", nil, "Only search entries with this tag (repeatable; all must match)")
+}
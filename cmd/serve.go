@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/server"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+const defaultServeAddr = "127.0.0.1:8080"
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve your records over a local REST API",
+	Long: `Starts an HTTP server with a JSON REST API over your records: list, add,
+edit and delete entries, search and stats. Ratings use your configured rating
+scale, like --output json. The OpenAPI document is at /api/openapi.json.
+
+With --ui it also serves a read-only dashboard at / : a sortable, filterable
+table of entries, yearly and rating charts like 'morama stats', and a page per
+title with every viewing. Everything is built into the binary, so it works
+offline.
+
+If server.token is set in ~/.morama/config.yaml, every request except the
+OpenAPI document needs the header "Authorization: Bearer <token>". Open the
+dashboard once with ?token=<token> and the browser remembers it.
+
+Examples:
+  morama serve
+  morama serve --addr 127.0.0.1:9000
+  morama serve --ui
+  curl http://127.0.0.1:8080/api/entries?type=movie&sort=rating`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("serve", args, time.Since(startTime))
+		}()
+
+		cfg := config.GetConfig()
+		addr, _ := cmd.Flags().GetString("addr")
+		ui, _ := cmd.Flags().GetBool("ui")
+		if !cmd.Flags().Changed("addr") && cfg.Server.Addr != "" {
+			addr = cfg.Server.Addr
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			utils.HandleError(utils.ValidationError(fmt.Sprintf("Invalid address %q (expected host:port)", addr), err), "Invalid address")
+		}
+		if cfg.Server.Token == "" && !isLoopback(addr) {
+			fmt.Fprintf(os.Stderr, "⚠️ %s is reachable from other machines and no server.token is set; anyone on the network can change your records.\n", addr)
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			utils.HandleError(utils.SystemError(fmt.Sprintf("Failed to listen on %s", addr), err), "Server error")
+		}
+
+		httpServer := &http.Server{
+			Handler: server.New(store, server.Options{
+				Scale:      ratingScale(),
+				Token:      cfg.Server.Token,
+				UI:         ui,
+				DateFormat: cfg.Display.DateFormat,
+			}),
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       2 * time.Minute,
+		}
+
+		// Ctrl-C나 SIGTERM을 받으면 처리 중인 요청을 마치고 종료
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+		go func() {
+			<-ctx.Done()
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			httpServer.Shutdown(shutdownCtx)
+		}()
+
+		utils.LogUserAction("server_started", fmt.Sprintf("addr: %s, auth: %t, ui: %t", listener.Addr(), cfg.Server.Token != "", ui))
+		fmt.Printf("🌐 Serving morama API on http://%s/api/ (Ctrl-C to stop)\n", listener.Addr())
+		if ui {
+			fmt.Printf("📊 Dashboard: http://%s/\n", listener.Addr())
+		}
+		if err := httpServer.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			utils.HandleError(utils.SystemError("Server stopped unexpectedly", err), "Server error")
+		}
+		fmt.Println("👋 Server stopped")
+	},
+}
+
+// 루프백 주소(localhost 포함)에만 바인딩하는지 여부
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+	serveCmd.Flags().String("addr", defaultServeAddr, "Address to listen on (host:port); defaults to server.addr in config.yaml")
+	serveCmd.Flags().Bool("ui", false, "Also serve a read-only HTML dashboard at /")
+}
@@ -4,39 +4,36 @@ import (
 	"fmt"
 	"strings"
 
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
-
 	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/render"
 	"github.com/kiku99/morama/internal/storage"
 	"github.com/kiku99/morama/internal/utils"
+	"github.com/mattn/go-runewidth"
 	"github.com/spf13/cobra"
 )
 
 var showCmd = &cobra.Command{
-	Use:   "show [title]",
-	Short: "Display detailed information about a selected movie or drama",
-	Long: `Shows detailed information about the movie or drama with the given title.
+	Use:   "show [title|id]",
+	Short: "Display detailed information about a selected entry",
+	Long: `Shows detailed information about the entry with the given title.
+With search.fuzzy_match enabled, titles also match regardless of case, spacing
+and full-width characters, and close misspellings get a "did you mean" hint.
+--type is only needed when entries of different types share the title.
+A numeric argument is looked up as an entry ID first.
+A title watched more than once is shown as a timeline of every viewing.
+Long-form reviews are word-wrapped to the terminal width.
 예시:
-  morama show "언젠가는 슬기로울 전공의생활" --drama
-  morama show "인셉션" --movie`,
+  morama show "인셉션"
+  morama show 12
+  morama show "언젠가는 슬기로울 전공의생활" --type drama
+  morama show "인셉션" --type movie`,
 
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		title := args[0]
-		isMovie, _ := cmd.Flags().GetBool("movie")
-		isDrama, _ := cmd.Flags().GetBool("drama")
-
-		if (isMovie && isDrama) || (!isMovie && !isDrama) {
-			fmt.Println("❌ Please specify either --movie or --drama (but not both)")
-			return
-		}
-
-		var mediaType models.MediaType
-		if isMovie {
-			mediaType = models.Movie
-		} else {
-			mediaType = models.Drama
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
 		}
 
 		store, err := storage.NewStorage()
@@ -46,44 +43,123 @@ var showCmd = &cobra.Command{
 		}
 		defer store.Close()
 
-		entries, err := store.FindAllByTitleAndType(title, mediaType)
+		entries, _, err := resolveEntryArg(store, title, mediaType)
 		if err != nil {
-			fmt.Printf("❌ 검색 중 오류 발생: %v\n", err)
-			return
+			utils.HandleError(err, "검색 중 오류 발생")
 		}
 
-		if len(entries) == 0 {
-			fmt.Printf("‼️ \"%s\" (%s)에 해당하는 항목이 없습니다.\n", title, mediaType)
+		// 여러 작품(비슷한 제목, 같은 제목의 다른 타입)이 일치하면 하나를 선택
+		selected, err := selectTitle(cmd, title, entries)
+		if err != nil {
+			utils.HandleError(err, "제목 선택 실패")
+		}
+		entries = filterByTitle(entries, selected)
+
+		if renderOutput(cmd, entriesOutput(entries)) {
 			return
 		}
 
-		for i, entry := range entries {
-			if len(entries) > 1 {
-				fmt.Printf("\n📄 Result %d/%d\n", i+1, len(entries))
-			}
-			printEntryBox(&entry)
+		// 같은 작품을 여러 번 봤으면 평점/한줄평이 어떻게 바뀌었는지 시간순으로 출력
+		history := models.GroupViewings(entries)[0]
+		if len(history.Viewings) == 1 {
+			printEntryBox(&history.Viewings[0])
+			return
 		}
+		printTimeline(history)
 	},
 }
 
 func init() {
 	rootCmd.AddCommand(showCmd)
-	showCmd.Flags().Bool("movie", false, "영화로 조회")
-	showCmd.Flags().Bool("drama", false, "드라마로 조회")
+	addTypeFlag(showCmd, "이 타입으로 조회 (같은 제목의 다른 타입이 있을 때)", true)
 }
 
 func printEntryBox(entry *models.MediaEntry) {
 	line := strings.Repeat("━", 60)
 	labelWidth := 6
-	c := cases.Title(language.Und)
 
 	fmt.Println(line)
-	fmt.Println(formatField("📌 Title", entry.Title, labelWidth))
-	fmt.Println(formatField("🎞️ Type", c.String(string(entry.Type)), labelWidth))
-	fmt.Println(formatField("⭐ Rating", fmt.Sprintf("%.1f / 5.0", entry.Rating), labelWidth))
-	fmt.Println(formatField("🗓️ Watched Date", entry.DateWatched.Format("2006-01-02"), labelWidth))
-	fmt.Println(formatField("💬 Comment", entry.Comment, labelWidth))
+	for _, field := range render.EntryFields(*entry, ratingScale()) {
+		fmt.Println(field.String(labelWidth))
+	}
+	if entry.Review != "" {
+		fmt.Println(line)
+		fmt.Println("📝 Review")
+		fmt.Println()
+		printWrapped(entry.Review, "   ", "   ")
+	}
+	fmt.Println(line)
+}
+
+// printTimeline 다시 본 작품의 시청 기록을 오래된 것부터 출력 (평점 변화 포함)
+func printTimeline(history models.TitleHistory) {
+	line := strings.Repeat("━", 60)
+	labelWidth := 6
+	latest := history.Latest()
+	scale := ratingScale()
+
+	fmt.Println(line)
+	fmt.Println(formatField("📌 Title", history.Title, labelWidth))
+	fmt.Println(formatField("🎞️ Type", history.Type.Label(), labelWidth))
+	fmt.Println(formatField("🔁 Viewings", fmt.Sprintf("%d (avg ⭐ %s)", len(history.Viewings), scale.FormatAverage(history.AvgRating())), labelWidth))
+	if history.Type.Info().Episodic {
+		status := string(latest.Status)
+		if progress := latest.Progress(); progress != "" {
+			status += " · " + progress
+		}
+		fmt.Println(formatField("📺 Status", status, labelWidth))
+	}
+	if len(latest.Tags) > 0 {
+		fmt.Println(formatField("🏷️ Tags", strings.Join(latest.Tags, ", "), labelWidth))
+	}
 	fmt.Println(line)
+
+	for i, viewing := range history.Viewings {
+		change := ""
+		if i > 0 {
+			change = ratingChange(scale, history.Viewings[i-1].Rating, viewing.Rating)
+		}
+		fmt.Printf("🗓️ %s  ⭐ %s %-6s  [%d]\n",
+			viewing.DateWatched.Format("2006-01-02"), scale.Format(viewing.Rating), change, viewing.ID)
+		if viewing.Comment != "" {
+			fmt.Printf("    💬 %s\n", viewing.Comment)
+		}
+		if viewing.Review != "" {
+			printWrapped(viewing.Review, "    📝 ", "       ")
+		}
+	}
+	fmt.Println(line)
+}
+
+// 이전 시청 대비 평점 변화 (예: "(+1.0)", 좋아요/싫어요 척도는 바뀌었을 때만 "(was 👎)")
+func ratingChange(scale models.RatingScale, previous, current float64) string {
+	diff := scale.Value(current) - scale.Value(previous)
+	switch {
+	case diff == 0:
+		return ""
+	case scale == models.ScaleThumbs:
+		return fmt.Sprintf("(was %s)", scale.Format(previous))
+	case scale == models.ScaleHundredPoint:
+		return fmt.Sprintf("(%+.0f)", diff)
+	default:
+		return fmt.Sprintf("(%+.1f)", diff)
+	}
+}
+
+// printWrapped 긴 글을 터미널 폭에 맞춰 줄바꿈해 출력 (첫 줄은 first, 나머지 줄은 rest를 앞에 붙임)
+func printWrapped(text, first, rest string) {
+	width := getTerminalWidth() - runewidth.StringWidth(rest)
+	for i, line := range utils.WrapText(text, width) {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			fmt.Println()
+			continue
+		}
+		fmt.Println(prefix + line)
+	}
 }
 
 func formatField(label string, value string, labelWidth int) string {
@@ -2,23 +2,81 @@ package cmd
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
 	"github.com/kiku99/morama/internal/storage"
 	"github.com/kiku99/morama/internal/utils"
 	"github.com/spf13/cobra"
 )
 
+// statsReport --output 직렬화용 stats (평점은 설정된 척도의 값)
+type statsReport struct {
+	models.Stats `yaml:",inline"`
+	RatingScale  models.RatingScale `json:"rating_scale" yaml:"rating_scale"`
+}
+
+// Header CSV/TSV 출력용: 지표 이름과 값 두 컬럼
+func (r statsReport) Header() []string {
+	return []string{"metric", "value"}
+}
+
+func (r statsReport) Rows() [][]string {
+	f := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	rows := [][]string{
+		{"total_entries", strconv.Itoa(r.TotalEntries)},
+		{"total_titles", strconv.Itoa(r.TotalTitles)},
+		{"rewatched_titles", strconv.Itoa(r.RewatchedTitles)},
+		{"rewatches", strconv.Itoa(r.Rewatches)},
+		{"avg_rating", f(r.AvgRating)},
+		{"rating_scale", string(r.RatingScale)},
+	}
+	for _, t := range r.Types {
+		rows = append(rows,
+			[]string{"types." + string(t.Type) + ".count", strconv.Itoa(t.Count)},
+			[]string{"types." + string(t.Type) + ".avg_rating", f(t.AvgRating)},
+		)
+	}
+	for _, b := range r.RatingDistribution {
+		rows = append(rows, []string{"rating_distribution." + f(b.Rating), strconv.Itoa(b.Count)})
+	}
+	for _, y := range r.Yearly {
+		prefix := "yearly." + strconv.Itoa(y.Year)
+		rows = append(rows, []string{prefix + ".count", strconv.Itoa(y.Count)})
+		for _, t := range y.Types {
+			rows = append(rows, []string{prefix + "." + string(t.Type), strconv.Itoa(t.Count)})
+		}
+		rows = append(rows, []string{prefix + ".avg_rating", f(y.AvgRating)})
+	}
+	for _, t := range r.Tags {
+		rows = append(rows,
+			[]string{"tags." + t.Name + ".count", strconv.Itoa(t.Count)},
+			[]string{"tags." + t.Name + ".avg_rating", f(t.AvgRating)},
+		)
+	}
+	rows = append(rows,
+		[]string{"watchlist.backlog", strconv.Itoa(r.Watchlist.Backlog)},
+		[]string{"watchlist.watched", strconv.Itoa(r.Watchlist.Watched)},
+		[]string{"watchlist.avg_days_to_watch", f(r.Watchlist.AvgDaysToWatch)},
+	)
+	if !r.LastWatched.IsZero() {
+		rows = append(rows, []string{"last_watched", r.LastWatched.Format(time.RFC3339)})
+	}
+	return rows
+}
+
 var statsCmd = &cobra.Command{
 	Use:   "stats",
-	Short: "Show statistics about your movie and drama collection",
-	Long: `Display comprehensive statistics about your movie and drama collection.
+	Short: "Show statistics about your collection",
+	Long: `Display comprehensive statistics about your collection.
 Shows total counts, average ratings, yearly breakdowns, and more.
 
 Examples:
-  morama stats`,
+  morama stats
+  morama stats --output json`,
 	Run: func(cmd *cobra.Command, args []string) {
 		startTime := time.Now()
 		defer func() {
@@ -36,7 +94,8 @@ Examples:
 		}
 		defer store.Close()
 
-		stats, err := store.GetStats()
+		scale := ratingScale()
+		stats, err := store.GetStats(scale)
 		if err != nil {
 			utils.HandleError(
 				utils.DatabaseError("Failed to retrieve statistics", err),
@@ -44,78 +103,84 @@ Examples:
 			)
 		}
 
+		if renderOutput(cmd, statsReport{Stats: stats.Scaled(scale), RatingScale: scale}) {
+			return
+		}
 		config := config.GetConfig()
+
 		fmt.Println("📊 Collection Statistics")
 		fmt.Println("=" + strings.Repeat("=", 50))
 
-		// 전체 영화/드라마 개수 출력
-		totalMovies := stats["total_movies"].(int)
-		totalDramas := stats["total_dramas"].(int)
-		totalEntries := totalMovies + totalDramas
-
-		fmt.Printf("📽️  Total Movies: %d\n", totalMovies)
-		fmt.Printf("📺  Total Dramas: %d\n", totalDramas)
-		fmt.Printf("📚  Total Entries: %d\n\n", totalEntries)
-
-		// 평균 평점 출력
-		if totalMovies > 0 {
-			avgMovieRating := stats["avg_movie_rating"].(float64)
-			fmt.Printf("⭐ Average Movie Rating: %.2f/%.1f\n", avgMovieRating, config.Display.RatingScale)
+		// 타입별 개수 출력 (항목이 있는 타입만)
+		for _, t := range stats.Types {
+			info := t.Type.Info()
+			fmt.Printf("%s  Total %s: %d\n", info.Icon, info.Plural, t.Count)
 		}
+		fmt.Printf("📚  Total Entries: %d\n", stats.TotalEntries)
+		if stats.Rewatches > 0 {
+			fmt.Printf("🔁  Rewatches: %d (%d of %d titles watched more than once)\n",
+				stats.Rewatches, stats.RewatchedTitles, stats.TotalTitles)
+		}
+		fmt.Println()
 
-		if totalDramas > 0 {
-			avgDramaRating := stats["avg_drama_rating"].(float64)
-			fmt.Printf("⭐ Average Drama Rating: %.2f/%.1f\n", avgDramaRating, config.Display.RatingScale)
+		// 평균 평점 출력
+		for _, t := range stats.Types {
+			fmt.Printf("⭐ Average %s Rating: %s\n", t.Type.Label(), scale.FormatAverageOutOf(t.AvgRating))
 		}
 
-		if totalEntries > 0 {
-			avgOverallRating := stats["avg_overall_rating"].(float64)
-			fmt.Printf("⭐ Overall Average Rating: %.2f/%.1f\n\n", avgOverallRating, config.Display.RatingScale)
+		if stats.TotalEntries > 0 {
+			fmt.Printf("⭐ Overall Average Rating: %s\n\n", scale.FormatAverageOutOf(stats.AvgRating))
 		}
 
 		// 별점 분포도 출력
-		if ratingDistribution, ok := stats["rating_distribution"].(map[string]int); ok {
+		if len(stats.RatingDistribution) > 0 {
 			fmt.Println("📈 Rating Distribution:")
-			for rating, count := range ratingDistribution {
-				if count > 0 {
-					percentage := float64(count) / float64(totalEntries) * 100
-					fmt.Printf("   %.1f stars: %d entries (%.1f%%)\n",
-						parseRating(rating), count, percentage)
-				}
+			for _, bucket := range stats.RatingDistribution {
+				fmt.Printf("   %s: %d entries (%.1f%%)\n",
+					scale.BucketLabel(bucket.Rating), bucket.Count, bucket.Percentage)
 			}
 			fmt.Println()
 		}
 
 		// 연도별 통계 출력
-		if yearlyStats, ok := stats["yearly_stats"].(map[string]interface{}); ok {
+		if len(stats.Yearly) > 0 {
 			fmt.Println("📅 Yearly Breakdown:")
-			for yearStr, yearData := range yearlyStats {
-				if yearDataMap, ok := yearData.(map[string]interface{}); ok {
-					movies := yearDataMap["movies"].(int)
-					dramas := yearDataMap["dramas"].(int)
-					avgRating := yearDataMap["avg_rating"].(float64)
-
-					fmt.Printf("   %s: %d movies, %d dramas (avg: %.2f)\n",
-						yearStr, movies, dramas, avgRating)
+			for _, year := range stats.Yearly {
+				counts := make([]string, len(year.Types))
+				for i, t := range year.Types {
+					counts[i] = fmt.Sprintf("%d %s", t.Count, strings.ToLower(t.Type.Info().Plural))
 				}
+				fmt.Printf("   %d: %s (avg: %s)\n", year.Year, strings.Join(counts, ", "), scale.FormatAverage(year.AvgRating))
+			}
+		}
+
+		// 태그별 통계 출력
+		if len(stats.Tags) > 0 {
+			fmt.Println("\n🏷️ Tags:")
+			for _, tag := range stats.Tags {
+				fmt.Printf("   %s: %d entries (avg: %s)\n", tag.Name, tag.Count, scale.FormatAverage(tag.AvgRating))
+			}
+		}
+
+		// 볼 예정 목록 출력
+		if stats.Watchlist.Backlog > 0 || stats.Watchlist.Watched > 0 {
+			fmt.Println("\n📌 Watchlist:")
+			fmt.Printf("   Backlog: %d titles\n", stats.Watchlist.Backlog)
+			if stats.Watchlist.Watched > 0 {
+				fmt.Printf("   Watched from watchlist: %d (avg %.1f days from queued to watched)\n",
+					stats.Watchlist.Watched, stats.Watchlist.AvgDaysToWatch)
 			}
 		}
 
 		// 마지막 시청일 출력
-		if lastWatched, ok := stats["last_watched"].(string); ok && lastWatched != "" {
-			fmt.Printf("\n🕒 Last Watched: %s\n", lastWatched)
+		if !stats.LastWatched.IsZero() {
+			fmt.Printf("\n🕒 Last Watched: %s\n", stats.LastWatched.Format(config.Display.DateFormat))
 		}
 
-		utils.LogUserAction("stats_completed", fmt.Sprintf("displayed stats for %d entries", totalEntries))
+		utils.LogUserAction("stats_completed", fmt.Sprintf("displayed stats for %d entries", stats.TotalEntries))
 	},
 }
 
-func parseRating(ratingStr string) float64 {
-	var rating float64
-	fmt.Sscanf(ratingStr, "%f", &rating)
-	return rating
-}
-
 func init() {
 	rootCmd.AddCommand(statsCmd)
 }
@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var tagCmd = &cobra.Command{
+	Use:   "tag",
+	Short: "Manage genres and tags",
+	Long: `Attach genres and tags to entries, and rename or merge tags.
+Tag names are case-insensitive; a tag disappears once no entry uses it.`,
+}
+
+var tagAddCmd = &cobra.Command{
+	Use:   "add [title|id] [tag...]",
+	Short: "Add tags to an entry",
+	Long: `Adds one or more tags to an entry. Tags can be separate arguments or comma-separated.
+
+Examples:
+  morama tag add 12 thriller korean
+  morama tag add "Parasite" "dark comedy,thriller"`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		tags := utils.ParseTags(args[1:])
+		if len(tags) == 0 {
+			utils.HandleError(utils.ValidationError("No tags given", nil), "Invalid tags")
+		}
+
+		store := openTagStorage()
+		defer store.Close()
+
+		entry := resolveTagEntry(cmd, store, args[0])
+		if err := store.AddTags(entry.ID, tags); err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to add tags", err), "Tag update error")
+		}
+
+		utils.LogUserAction("tags_added", fmt.Sprintf("id: %d, tags: %s", entry.ID, strings.Join(tags, ", ")))
+		fmt.Printf("🏷️ Tagged [%d] %s with %s\n", entry.ID, entry.Title, strings.Join(tags, ", "))
+	},
+}
+
+var tagRemoveCmd = &cobra.Command{
+	Use:   "remove [title|id] [tag...]",
+	Short: "Remove tags from an entry",
+	Long: `Removes one or more tags from an entry.
+
+Examples:
+  morama tag remove 12 korean`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		tags := utils.ParseTags(args[1:])
+
+		store := openTagStorage()
+		defer store.Close()
+
+		entry := resolveTagEntry(cmd, store, args[0])
+		removed, err := store.RemoveTags(entry.ID, tags)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to remove tags", err), "Tag update error")
+		}
+		if removed == 0 {
+			utils.HandleError(
+				utils.NotFoundError(fmt.Sprintf("[%d] %s has none of the tags %s", entry.ID, entry.Title, strings.Join(tags, ", ")), nil),
+				"Tag not found",
+			)
+		}
+
+		utils.LogUserAction("tags_removed", fmt.Sprintf("id: %d, tags: %s", entry.ID, strings.Join(tags, ", ")))
+		fmt.Printf("🗑️ Removed %d tag(s) from [%d] %s\n", removed, entry.ID, entry.Title)
+	},
+}
+
+var tagListCmd = &cobra.Command{
+	Use:   "list [title|id]",
+	Short: "List tags",
+	Long: `Lists every tag with the number of entries and their average rating,
+or the tags of a single entry when a title or ID is given.
+
+Examples:
+  morama tag list
+  morama tag list 12
+  morama tag list --output json`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("tag list", args, time.Since(startTime))
+		}()
+
+		store := openTagStorage()
+		defer store.Close()
+
+		if len(args) == 1 {
+			entry := resolveTagEntry(cmd, store, args[0])
+			if renderOutput(cmd, entryTags(entry.Tags)) {
+				return
+			}
+			if len(entry.Tags) == 0 {
+				fmt.Printf("🏷️ [%d] %s has no tags.\n", entry.ID, entry.Title)
+				return
+			}
+			fmt.Printf("🏷️ [%d] %s: %s\n", entry.ID, entry.Title, strings.Join(entry.Tags, ", "))
+			return
+		}
+
+		tags, err := store.ListTags()
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to list tags", err), "Tag list error")
+		}
+		if renderOutput(cmd, tagTable(ratingScale().ScaleTags(tags))) {
+			return
+		}
+		if len(tags) == 0 {
+			fmt.Println("📭 No tags yet. Add one with 'morama tag add' or --tag.")
+			return
+		}
+		printTagStats(tags)
+	},
+}
+
+var tagRenameCmd = &cobra.Command{
+	Use:   "rename [old] [new]",
+	Short: "Rename a tag",
+	Long: `Renames a tag on every entry. Use merge if the new name is already a tag.
+
+Examples:
+  morama tag rename scifi sf`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		newName := strings.Join(strings.Fields(args[1]), " ")
+
+		store := openTagStorage()
+		defer store.Close()
+
+		if err := store.RenameTag(args[0], newName); err != nil {
+			handleTagError(err, "Failed to rename tag")
+		}
+
+		utils.LogUserAction("tag_renamed", fmt.Sprintf("%s -> %s", args[0], newName))
+		fmt.Printf("✅ Renamed tag \"%s\" to \"%s\"\n", args[0], newName)
+	},
+}
+
+var tagMergeCmd = &cobra.Command{
+	Use:   "merge [source...] [target]",
+	Short: "Merge tags into one",
+	Long: `Moves every entry tagged with the source tags to the target tag
+and removes the source tags. The target tag is created if needed.
+
+Examples:
+  morama tag merge scifi sci-fi sf`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		sources, target := args[:len(args)-1], strings.Join(strings.Fields(args[len(args)-1]), " ")
+
+		store := openTagStorage()
+		defer store.Close()
+
+		affected, err := store.MergeTags(sources, target)
+		if err != nil {
+			handleTagError(err, "Failed to merge tags")
+		}
+
+		utils.LogUserAction("tags_merged", fmt.Sprintf("%s -> %s", strings.Join(sources, ", "), target))
+		fmt.Printf("✅ Merged %s into \"%s\" (%d entries)\n", strings.Join(sources, ", "), target, affected)
+	},
+}
+
+func openTagStorage() *storage.Storage {
+	store, err := storage.NewStorage()
+	if err != nil {
+		utils.HandleError(
+			utils.DatabaseError("Failed to initialize storage", err),
+			"Storage initialization error",
+		)
+	}
+	return store
+}
+
+func resolveTagEntry(cmd *cobra.Command, store *storage.Storage, arg string) *models.MediaEntry {
+	entry, err := resolveEntry(cmd, store, arg, "")
+	if err != nil {
+		utils.HandleError(err, "Error finding entry")
+	}
+	return entry
+}
+
+// 태그 에러를 종류에 맞는 AppError로 변환
+func handleTagError(err error, message string) {
+	switch {
+	case errors.Is(err, storage.ErrTagNotFound):
+		utils.HandleError(utils.NotFoundError(err.Error(), err), message)
+	case errors.Is(err, storage.ErrTagExists):
+		utils.HandleError(utils.ValidationError(err.Error()+"; use 'morama tag merge' instead", err), message)
+	default:
+		utils.HandleError(utils.DatabaseError(message, err), message)
+	}
+}
+
+func printTagStats(tags []models.TagStats) {
+	scale := ratingScale()
+	nameWidth := len("Tag")
+	for _, tag := range tags {
+		nameWidth = utils.MaxInt(nameWidth, runewidth.StringWidth(tag.Name))
+	}
+
+	fmt.Printf("%s  %7s  %10s\n", utils.PadStringToWidth("Tag", nameWidth), "Entries", "Avg Rating")
+	fmt.Println(strings.Repeat("─", nameWidth+21))
+	for _, tag := range tags {
+		fmt.Printf("%s  %7d  %10s\n", utils.PadStringToWidth(tag.Name, nameWidth), tag.Count, scale.FormatAverage(tag.AvgRating))
+	}
+}
+
+// tagTable --output용 태그 목록 (평균 평점은 척도 값)
+type tagTable []models.TagStats
+
+func (t tagTable) Header() []string {
+	return []string{"name", "count", "avg_rating"}
+}
+
+func (t tagTable) Rows() [][]string {
+	rows := make([][]string, len(t))
+	for i, tag := range t {
+		rows[i] = []string{tag.Name, strconv.Itoa(tag.Count), strconv.FormatFloat(tag.AvgRating, 'f', -1, 64)}
+	}
+	return rows
+}
+
+// entryTags --output용 한 항목의 태그 목록
+type entryTags []string
+
+func (t entryTags) Header() []string {
+	return []string{"name"}
+}
+
+func (t entryTags) Rows() [][]string {
+	rows := make([][]string, len(t))
+	for i, tag := range t {
+		rows[i] = []string{tag}
+	}
+	return rows
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagAddCmd)
+	tagCmd.AddCommand(tagRemoveCmd)
+	tagCmd.AddCommand(tagListCmd)
+	tagCmd.AddCommand(tagRenameCmd)
+	tagCmd.AddCommand(tagMergeCmd)
+}
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "Manage deleted entries",
+	Long: `Deleted entries go to the trash instead of disappearing. They are hidden
+from list, show, search and stats until you restore them, and stay recoverable
+until you purge them.`,
+}
+
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List entries in the trash",
+	Long: `Lists deleted entries, most recently deleted first.
+
+Examples:
+  morama trash list
+  morama trash list --output json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("trash list", args, time.Since(startTime))
+		}()
+
+		store := openTrashStorage()
+		defer store.Close()
+
+		entries, err := store.ListTrash()
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to list the trash", err), "Trash list error")
+		}
+		if renderOutput(cmd, entriesOutput(entries)) {
+			return
+		}
+		if len(entries) == 0 {
+			fmt.Println("🗑️ The trash is empty.")
+			return
+		}
+		printTrash(entries)
+	},
+}
+
+var trashRestoreCmd = &cobra.Command{
+	Use:   "restore [id...]",
+	Short: "Restore entries from the trash",
+	Long: `Moves entries back out of the trash. IDs can be listed as arguments,
+including ranges.
+
+Examples:
+  morama trash restore 12
+  morama trash restore 10-20 25`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		ids, err := utils.ParseIDList(args)
+		if err != nil {
+			utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid ID")
+		}
+
+		store := openTrashStorage()
+		defer store.Close()
+
+		restored, err := store.RestoreEntries(ids)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to restore entries", err), "Trash restore error")
+		}
+		for _, id := range restored {
+			fmt.Printf("♻️ Restored entry %d.\n", id)
+		}
+		utils.LogUserAction("entries_restored", fmt.Sprintf("ids: %v", restored))
+
+		if missing := missingIDs(ids, restored); len(missing) > 0 {
+			utils.HandleError(
+				utils.NotFoundError(fmt.Sprintf("No entry in the trash with ID %s", strings.Join(missing, ", ")), nil),
+				"Entry not found",
+			)
+		}
+	},
+}
+
+var trashPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "Permanently remove entries from the trash",
+	Long: `Permanently removes entries from the trash. With --older-than only entries
+deleted before that age are removed (h hours, d days, w weeks, m months, y years).
+Purged entries cannot be restored or undone. Asks for confirmation unless --yes
+is given.
+
+Examples:
+  morama trash purge --older-than 30d
+  morama trash purge --yes`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		var cutoff time.Time
+		olderThan, _ := cmd.Flags().GetString("older-than")
+		if olderThan != "" {
+			var err error
+			if cutoff, err = utils.ParseAge(olderThan, time.Now()); err != nil {
+				utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid --older-than")
+			}
+		}
+
+		store := openTrashStorage()
+		defer store.Close()
+
+		count, err := store.CountTrash(cutoff)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to count the trash", err), "Trash purge error")
+		}
+		if count == 0 {
+			fmt.Println("🗑️ Nothing to purge.")
+			return
+		}
+
+		ok, err := confirmAction(cmd, fmt.Sprintf("Permanently remove %d entries from the trash", count))
+		if err != nil {
+			utils.HandleError(err, "Confirmation required")
+		}
+		if !ok {
+			fmt.Println("❎ Cancelled; nothing was purged.")
+			return
+		}
+
+		purged, err := store.PurgeTrash(cutoff)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to purge the trash", err), "Trash purge error")
+		}
+		utils.LogUserAction("trash_purged", fmt.Sprintf("entries: %d, older than: %q", purged, olderThan))
+		fmt.Printf("🔥 Permanently removed %d entries.\n", purged)
+	},
+}
+
+func openTrashStorage() *storage.Storage {
+	store, err := storage.NewStorage()
+	if err != nil {
+		utils.HandleError(
+			utils.DatabaseError("Failed to initialize storage", err),
+			"Storage initialization error",
+		)
+	}
+	return store
+}
+
+func printTrash(entries []models.MediaEntry) {
+	cfg := config.GetConfig()
+	scale := ratingScale()
+	titleWidth := len("Title")
+	for _, entry := range entries {
+		titleWidth = utils.MaxInt(titleWidth, runewidth.StringWidth(entry.Title))
+	}
+	if titleWidth > 40 {
+		titleWidth = 40
+	}
+
+	fmt.Printf("%4s  %s  %-12s  %-6s  %s\n", "ID", utils.PadStringToWidth("Title", titleWidth), "Type", "Rating", "Deleted")
+	fmt.Println(strings.Repeat("─", titleWidth+44))
+	for _, entry := range entries {
+		deleted := entry.DeletedAt.Format(cfg.Display.DateFormat)
+		if days := int(time.Since(entry.DeletedAt).Hours() / 24); days > 0 {
+			deleted += fmt.Sprintf(" (%dd ago)", days)
+		}
+		fmt.Printf("%4d  %s  %s  %s  %s\n",
+			entry.ID,
+			utils.PadStringToWidth(utils.TruncateStringWithWidth(entry.Title, titleWidth), titleWidth),
+			utils.PadStringToWidth(string(entry.Type), 12),
+			utils.PadStringToWidth(scale.Format(entry.Rating), 6),
+			deleted)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(trashCmd)
+	trashCmd.AddCommand(trashListCmd)
+	trashCmd.AddCommand(trashRestoreCmd)
+	trashCmd.AddCommand(trashPurgeCmd)
+
+	trashPurgeCmd.Flags().String("older-than", "", "Only purge entries deleted longer ago than this (e.g. 30d, 2w, 6m)")
+	trashPurgeCmd.Flags().BoolP("yes", "y", false, "Don't ask for confirmation")
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"os"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/tui"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var tuiCmd = &cobra.Command{
+	Use:   "tui",
+	Short: "Browse and edit entries in a full-screen terminal UI",
+	Long: `Opens a full-screen browser over your entries: a scrollable table with the
+same columns as 'morama list' and a detail pane for the selected entry.
+
+Keys:
+  ↑/k ↓/j, PgUp/PgDn, g/G   Move through the table
+  /                          Filter by title, comment, type or tag (Esc clears)
+  a                          Add an entry
+  Enter/e                    Edit the selected entry
+  r, +/-                     Rate, or raise/lower the rating one step
+  d                          Delete the selected entry (asks first)
+  ?                          Show all keys
+  q                          Quit
+
+Examples:
+  morama tui
+  morama tui --type anime`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("tui", args, time.Since(startTime))
+		}()
+
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		store, err := storage.NewStorage()
+		if err != nil {
+			utils.HandleError(
+				utils.DatabaseError("Failed to initialize storage", err),
+				"Storage initialization error",
+			)
+		}
+		defer store.Close()
+
+		app := tui.New(store, tui.Options{
+			Query:      storage.Query{Type: mediaType},
+			Scale:      ratingScale(),
+			DateFormat: config.GetConfig().Display.DateFormat,
+		})
+
+		terminal, err := tui.OpenTerminal(os.Stdin, os.Stdout)
+		if err != nil {
+			utils.HandleError(utils.UserInputError("morama tui needs an interactive terminal", err), "Terminal error")
+		}
+		err = app.Run(terminal)
+		terminal.Close()
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to run the browser", err), "TUI error")
+		}
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tuiCmd)
+	addTypeFlag(tuiCmd, "Only show this media type", false)
+}
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/spf13/cobra"
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo",
+	Short: "Undo the most recent changes",
+	Long: `Reverts the most recent add, edit, tag change, delete or import, restoring every
+affected entry exactly as it was (including its ID, tags and review).
+Undone operations can be re-applied with 'morama redo' until you make a new change.
+See what would be undone with 'morama history'.
+
+Examples:
+  morama undo
+  morama undo --steps 3`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runReplay(cmd, "undo")
+	},
+}
+
+var redoCmd = &cobra.Command{
+	Use:   "redo",
+	Short: "Re-apply changes reverted with undo",
+	Long: `Re-applies operations reverted with 'morama undo', oldest first.
+Making any new change clears the operations that can be redone.
+
+Examples:
+  morama redo
+  morama redo --steps 2`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		runReplay(cmd, "redo")
+	},
+}
+
+// undo/redo 공통 처리
+func runReplay(cmd *cobra.Command, action string) {
+	startTime := time.Now()
+	defer func() {
+		utils.LogCommandExecution(action, nil, time.Since(startTime))
+	}()
+
+	steps, _ := cmd.Flags().GetInt("steps")
+	if steps < 1 {
+		utils.HandleError(utils.ValidationError("--steps must be at least 1", nil), "Invalid steps")
+	}
+
+	store, err := storage.NewStorage()
+	if err != nil {
+		utils.HandleError(
+			utils.DatabaseError("Failed to initialize storage", err),
+			"Storage initialization error",
+		)
+	}
+	defer store.Close()
+
+	replay, icon, verb := store.Undo, "↩️", "Undid"
+	if action == "redo" {
+		replay, icon, verb = store.Redo, "↪️", "Redid"
+	}
+
+	operations, err := replay(steps)
+	if err != nil {
+		utils.HandleError(utils.DatabaseError(fmt.Sprintf("Failed to %s", action), err), "Journal error")
+	}
+	if len(operations) == 0 {
+		fmt.Printf("🤷 Nothing to %s.\n", action)
+		return
+	}
+
+	for _, op := range operations {
+		fmt.Printf("%s %s #%d: %s\n", icon, verb, op.ID, op.Summary)
+	}
+	if len(operations) < steps {
+		fmt.Printf("ℹ️ Only %d operation(s) could be %s.\n", len(operations), map[string]string{"undo": "undone", "redo": "redone"}[action])
+	}
+	utils.LogUserAction(action, fmt.Sprintf("operations: %v", journalIDs(operations)))
+}
+
+func journalIDs(operations []models.JournalEntry) []int {
+	ids := make([]int, len(operations))
+	for i, op := range operations {
+		ids[i] = op.ID
+	}
+	return ids
+}
+
+func init() {
+	rootCmd.AddCommand(undoCmd)
+	rootCmd.AddCommand(redoCmd)
+	undoCmd.Flags().Int("steps", 1, "Number of operations to undo")
+	redoCmd.Flags().Int("steps", 1, "Number of operations to redo")
+}
@@ -0,0 +1,324 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/config"
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+	"github.com/mattn/go-runewidth"
+	"github.com/spf13/cobra"
+)
+
+var watchlistCmd = &cobra.Command{
+	Use:     "watchlist",
+	Aliases: []string{"wl"},
+	Short:   "Manage titles you plan to watch",
+	Long: `Keep a queue of titles you plan to watch, with a priority, who recommended
+them and when they were added. Once you have watched one, promote it to record
+it like 'morama add' would.`,
+}
+
+var watchlistAddCmd = &cobra.Command{
+	Use:   "add [title]",
+	Short: "Add a title to the watchlist",
+	Long: `Adds a title you plan to watch.
+
+Examples:
+  morama watchlist add "Past Lives" --type movie
+  morama watchlist add "Frieren" --type anime --priority high --by Minji
+  morama watchlist add "Mr. Sunshine" --type drama --note "Start before the trip"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		title := strings.TrimSpace(args[0])
+		if title == "" {
+			utils.HandleError(utils.ValidationError("Title must not be empty", nil), "Invalid title")
+		}
+
+		mediaType, err := requiredMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		priorityStr, _ := cmd.Flags().GetString("priority")
+		priority, err := models.ParsePriority(priorityStr)
+		if err != nil {
+			utils.HandleError(utils.ValidationError(err.Error(), err), "Invalid priority")
+		}
+
+		recommendedBy, _ := cmd.Flags().GetString("by")
+		note, _ := cmd.Flags().GetString("note")
+
+		store := openWatchlistStorage()
+		defer store.Close()
+
+		id, err := store.AddWatchlistItem(models.WatchlistItem{
+			Title:         title,
+			Type:          mediaType,
+			Priority:      priority,
+			RecommendedBy: strings.TrimSpace(recommendedBy),
+			Note:          note,
+		})
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to add to watchlist", err), "Watchlist update error")
+		}
+
+		utils.LogUserAction("watchlist_added", fmt.Sprintf("id: %d, title: %s, type: %s, priority: %s", id, title, mediaType, priority))
+		fmt.Printf("📌 Added [%d] %s to your watchlist (%s priority)\n", id, title, priority)
+	},
+}
+
+var watchlistListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the watchlist",
+	Long: `Lists titles you have not watched yet, highest priority first and
+oldest first within the same priority.
+
+Examples:
+  morama watchlist list
+  morama watchlist list --type anime
+  morama watchlist list --output json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		startTime := time.Now()
+		defer func() {
+			utils.LogCommandExecution("watchlist list", args, time.Since(startTime))
+		}()
+
+		mediaType, err := optionalMediaType(cmd)
+		if err != nil {
+			utils.HandleError(err, "Invalid media type specification")
+		}
+
+		store := openWatchlistStorage()
+		defer store.Close()
+
+		items, err := store.ListWatchlist(mediaType)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to list watchlist", err), "Watchlist list error")
+		}
+		if renderOutput(cmd, watchlistTable(items)) {
+			return
+		}
+		if len(items) == 0 {
+			fmt.Println("📭 Your watchlist is empty. Queue something with 'morama watchlist add'.")
+			return
+		}
+		printWatchlist(items)
+	},
+}
+
+var watchlistRemoveCmd = &cobra.Command{
+	Use:   "remove [id|title...]",
+	Short: "Remove titles from the watchlist",
+	Long: `Removes titles you no longer plan to watch. IDs can be listed as
+arguments, including ranges; a non-numeric argument is matched against titles.
+
+Examples:
+  morama watchlist remove 3
+  morama watchlist remove 3-5 8
+  morama watchlist remove "Past Lives"`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := openWatchlistStorage()
+		defer store.Close()
+
+		var ids []int
+		for _, arg := range args {
+			if argIDs, err := utils.ParseIDList([]string{arg}); err == nil {
+				ids = append(ids, argIDs...)
+				continue
+			}
+			item, err := resolveWatchlistItem(store, arg)
+			if err != nil {
+				utils.HandleError(err, "Error finding watchlist item")
+			}
+			ids = append(ids, item.ID)
+		}
+
+		removed, err := store.RemoveWatchlistItems(ids)
+		if err != nil {
+			utils.HandleError(utils.DatabaseError("Failed to remove from watchlist", err), "Watchlist update error")
+		}
+		for _, id := range removed {
+			fmt.Printf("🗑️ Removed watchlist item %d.\n", id)
+		}
+		utils.LogUserAction("watchlist_removed", fmt.Sprintf("ids: %v", removed))
+
+		if missing := missingIDs(ids, removed); len(missing) > 0 {
+			utils.HandleError(
+				utils.NotFoundError(fmt.Sprintf("No watchlist item found with ID %s", strings.Join(missing, ", ")), nil),
+				"Watchlist item not found",
+			)
+		}
+	},
+}
+
+var watchlistPromoteCmd = &cobra.Command{
+	Use:   "promote [id|title]",
+	Short: "Record a watchlist title as watched",
+	Long: `Records a title from the watchlist as watched and takes it off the list.
+Rating, review, date, tags and progress work exactly like 'morama add'.
+
+Examples:
+  morama watchlist promote 3
+  morama watchlist promote "Past Lives" --rating 4.5 --comment "Quietly devastating"
+  morama watchlist promote 5 --date yesterday --tag romance`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		store := openWatchlistStorage()
+		defer store.Close()
+
+		item, err := resolveWatchlistItem(store, args[0])
+		if err != nil {
+			utils.HandleError(err, "Error finding watchlist item")
+		}
+
+		fmt.Printf("🎬 %s (%s)\n", item.Title, item.Type.Label())
+		entry := newEntryFromInput(cmd, item.Title, item.Type)
+
+		mediaID, err := store.PromoteWatchlistItem(item.ID, entry)
+		if err != nil {
+			if errors.Is(err, storage.ErrWatchlistItemNotFound) {
+				utils.HandleError(utils.NotFoundError(err.Error(), err), "Watchlist item not found")
+			}
+			utils.HandleError(entryWriteError(err, "Failed to record watchlist item"), "Watchlist update error")
+		}
+
+		utils.LogUserAction("watchlist_promoted", fmt.Sprintf("watchlist id: %d, entry id: %d, rating: %s", item.ID, mediaID, formatRating(entry.Rating)))
+		fmt.Printf("✅ Recorded [%d] %s and removed it from your watchlist\n", mediaID, item.Title)
+	},
+}
+
+func openWatchlistStorage() *storage.Storage {
+	store, err := storage.NewStorage()
+	if err != nil {
+		utils.HandleError(
+			utils.DatabaseError("Failed to initialize storage", err),
+			"Storage initialization error",
+		)
+	}
+	return store
+}
+
+// resolveWatchlistItem ID 또는 제목(대소문자/공백 무시)으로 목록의 항목 하나를 찾음
+// 숫자 인자는 먼저 ID로 조회하고, 없으면 제목으로 다시 찾음
+func resolveWatchlistItem(store *storage.Storage, arg string) (models.WatchlistItem, error) {
+	if id, err := utils.ParseID(arg); err == nil && id > 0 {
+		item, err := store.GetWatchlistItem(id)
+		if err == nil {
+			return item, nil
+		}
+		if !errors.Is(err, storage.ErrWatchlistItemNotFound) {
+			return item, utils.DatabaseError("Failed to load watchlist item", err)
+		}
+	}
+
+	items, err := store.ListWatchlist("")
+	if err != nil {
+		return models.WatchlistItem{}, utils.DatabaseError("Failed to list watchlist", err)
+	}
+
+	var matches []models.WatchlistItem
+	normalized := utils.NormalizeTitle(arg)
+	for _, item := range items {
+		if utils.NormalizeTitle(item.Title) == normalized {
+			matches = append(matches, item)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return models.WatchlistItem{}, utils.NotFoundError(fmt.Sprintf("No watchlist item found for \"%s\"", arg), nil)
+	case 1:
+		return matches[0], nil
+	default:
+		choices := make([]string, len(matches))
+		for i, item := range matches {
+			choices[i] = fmt.Sprintf("%d: %s (%s)", item.ID, item.Title, item.Type)
+		}
+		return models.WatchlistItem{}, utils.UserInputError(
+			fmt.Sprintf("\"%s\" matches several watchlist items; use an ID (%s)", arg, strings.Join(choices, "; ")), nil)
+	}
+}
+
+// 우선순위별 표시 아이콘
+var priorityIcons = map[models.Priority]string{
+	models.PriorityHigh:   "🔥",
+	models.PriorityNormal: "•",
+	models.PriorityLow:    "💤",
+}
+
+func printWatchlist(items []models.WatchlistItem) {
+	cfg := config.GetConfig()
+	titleWidth, byWidth := len("Title"), len("Recommended by")
+	for _, item := range items {
+		titleWidth = utils.MaxInt(titleWidth, runewidth.StringWidth(item.Title))
+		byWidth = utils.MaxInt(byWidth, runewidth.StringWidth(item.RecommendedBy))
+	}
+	if titleWidth > 40 {
+		titleWidth = 40
+	}
+
+	fmt.Printf("%4s  %-10s  %s  %-12s  %s  %s\n", "ID", "Priority",
+		utils.PadStringToWidth("Title", titleWidth), "Type", utils.PadStringToWidth("Recommended by", byWidth), "Added")
+	fmt.Println(strings.Repeat("─", titleWidth+byWidth+50))
+	for _, item := range items {
+		added := item.AddedAt.Format(cfg.Display.DateFormat)
+		if days := int(time.Since(item.AddedAt).Hours() / 24); days > 0 {
+			added += fmt.Sprintf(" (%dd)", days)
+		}
+		fmt.Printf("%4d  %s  %s  %s  %s  %s\n",
+			item.ID,
+			utils.PadStringToWidth(priorityIcons[item.Priority]+" "+item.Priority.String(), 10),
+			utils.PadStringToWidth(utils.TruncateStringWithWidth(item.Title, titleWidth), titleWidth),
+			utils.PadStringToWidth(string(item.Type), 12),
+			utils.PadStringToWidth(item.RecommendedBy, byWidth),
+			added)
+		if item.Note != "" {
+			fmt.Printf("%4s  %s💬 %s\n", "", strings.Repeat(" ", 12), item.Note)
+		}
+	}
+}
+
+// watchlistTable --output용 볼 예정 목록
+type watchlistTable []models.WatchlistItem
+
+func (t watchlistTable) Header() []string {
+	return []string{"id", "title", "type", "priority", "recommended_by", "note", "added_at"}
+}
+
+func (t watchlistTable) Rows() [][]string {
+	rows := make([][]string, len(t))
+	for i, item := range t {
+		rows[i] = []string{
+			strconv.Itoa(item.ID), item.Title, string(item.Type), item.Priority.String(),
+			item.RecommendedBy, item.Note, item.AddedAt.Format(time.RFC3339),
+		}
+	}
+	return rows
+}
+
+func init() {
+	rootCmd.AddCommand(watchlistCmd)
+	watchlistCmd.AddCommand(watchlistAddCmd)
+	watchlistCmd.AddCommand(watchlistListCmd)
+	watchlistCmd.AddCommand(watchlistRemoveCmd)
+	watchlistCmd.AddCommand(watchlistPromoteCmd)
+
+	addTypeFlag(watchlistAddCmd, "Media type (movie, drama, anime, documentary, variety, miniseries, ...)", false)
+	watchlistAddCmd.Flags().String("priority", "normal", "Priority: high, normal or low")
+	watchlistAddCmd.Flags().String("by", "", "Who recommended it")
+	watchlistAddCmd.Flags().String("note", "", "Note about why or where to watch it")
+	watchlistAddCmd.RegisterFlagCompletionFunc("priority", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"high", "normal", "low"}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	addTypeFlag(watchlistListCmd, "Only show this media type", false)
+	addNewEntryFlags(watchlistPromoteCmd)
+}
@@ -5,20 +5,24 @@ import (
 	"path/filepath"
 
 	"gopkg.in/yaml.v3"
+
+	"github.com/kiku99/morama/internal/models"
 )
 
 // Config 설정 구조체
 type Config struct {
 	Display   DisplayConfig `yaml:"display"`
 	Search    SearchConfig  `yaml:"search"`
+	Types     []TypeConfig  `yaml:"types,omitempty"`
+	Server    ServerConfig  `yaml:"server,omitempty"`
 	DebugMode bool          `yaml:"debug_mode"`
 }
 
 // DisplayConfig 출력 관련 설정
 type DisplayConfig struct {
-	DateFormat  string  `yaml:"date_format"`  // 날짜 출력 형식
-	RatingScale float64 `yaml:"rating_scale"` // 평점의 최대값
-	ShowEmojis  bool    `yaml:"show_emojis"`  // 출력에 이모지를 보여줄지
+	DateFormat  string             `yaml:"date_format"`  // 날짜 출력 형식
+	RatingScale models.RatingScale `yaml:"rating_scale"` // 평점 척도: 5-star, 10-point, 100-point, thumbs (이전 값 5/10/100도 허용)
+	ShowEmojis  bool               `yaml:"show_emojis"`  // 출력에 이모지를 보여줄지
 }
 
 // SearchConfig 검색 관련 설정
@@ -28,12 +32,26 @@ type SearchConfig struct {
 	MaxResults    int  `yaml:"max_results"`
 }
 
+// ServerConfig morama serve 설정
+type ServerConfig struct {
+	Addr  string `yaml:"addr,omitempty"`  // 기본 수신 주소 (--addr가 우선)
+	Token string `yaml:"token,omitempty"` // 설정하면 API 요청에 Authorization: Bearer <token> 필요
+}
+
+// TypeConfig 사용자 정의 미디어 타입 (기본 타입과 이름이 같으면 표시 이름만 변경)
+type TypeConfig struct {
+	Name   string `yaml:"name"`             // --type에 쓰는 이름 (예: podcast)
+	Label  string `yaml:"label,omitempty"`  // 표시 이름 (예: Podcast)
+	Plural string `yaml:"plural,omitempty"` // 복수 표시 이름 (예: Podcasts)
+	Icon   string `yaml:"icon,omitempty"`   // 이모지
+}
+
 // DefaultConfig 기본 설정값 반환
 func DefaultConfig() *Config {
 	return &Config{
 		Display: DisplayConfig{
 			DateFormat:  "2006-01-02",
-			RatingScale: 5.0,
+			RatingScale: models.ScaleFiveStar,
 			ShowEmojis:  true,
 		},
 		Search: SearchConfig{
@@ -109,7 +127,7 @@ func mergeWithDefaults(config Config) *Config {
 	if config.Display.DateFormat == "" {
 		config.Display.DateFormat = defaults.Display.DateFormat
 	}
-	if config.Display.RatingScale == 0 {
+	if config.Display.RatingScale == "" {
 		config.Display.RatingScale = defaults.Display.RatingScale
 	}
 	if config.Search.MaxResults == 0 {
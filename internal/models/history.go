@@ -0,0 +1,86 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// TitleHistory 한 작품(제목과 타입이 같은 항목)의 시청 기록
+// media 테이블의 각 항목이 한 번의 시청이고, 같은 작품을 다시 보면 항목이 하나 더 생김
+type TitleHistory struct {
+	Title    string       `json:"title" yaml:"title"`
+	Type     MediaType    `json:"type" yaml:"type"`
+	Viewings []MediaEntry `json:"viewings" yaml:"viewings"` // 시청일순 (처음 본 것 먼저)
+}
+
+// GroupViewings 항목을 작품별로 묶음
+// 작품 순서는 entries에서 처음 나온 순서를 따르고, 각 작품의 시청 기록은 시청일순으로 정렬
+func GroupViewings(entries []MediaEntry) []TitleHistory {
+	type titleKey struct {
+		title     string
+		mediaType MediaType
+	}
+	index := make(map[titleKey]int)
+	var histories []TitleHistory
+	for _, entry := range entries {
+		key := titleKey{entry.Title, entry.Type}
+		i, ok := index[key]
+		if !ok {
+			i = len(histories)
+			index[key] = i
+			histories = append(histories, TitleHistory{Title: entry.Title, Type: entry.Type})
+		}
+		histories[i].Viewings = append(histories[i].Viewings, entry)
+	}
+
+	for _, h := range histories {
+		sort.SliceStable(h.Viewings, func(i, j int) bool {
+			a, b := h.Viewings[i], h.Viewings[j]
+			if !a.DateWatched.Equal(b.DateWatched) {
+				return a.DateWatched.Before(b.DateWatched)
+			}
+			return a.ID < b.ID
+		})
+	}
+	return histories
+}
+
+// Rewatches 처음 본 뒤 다시 본 횟수
+func (h TitleHistory) Rewatches() int {
+	if len(h.Viewings) == 0 {
+		return 0
+	}
+	return len(h.Viewings) - 1
+}
+
+// Latest 가장 최근 시청 기록
+func (h TitleHistory) Latest() MediaEntry {
+	if len(h.Viewings) == 0 {
+		return MediaEntry{}
+	}
+	return h.Viewings[len(h.Viewings)-1]
+}
+
+// FirstWatched 처음 본 날
+func (h TitleHistory) FirstWatched() time.Time {
+	if len(h.Viewings) == 0 {
+		return time.Time{}
+	}
+	return h.Viewings[0].DateWatched
+}
+
+// AvgRating 시청 기록의 평균 평점 (평점 0은 제외)
+func (h TitleHistory) AvgRating() float64 {
+	var sum float64
+	var count int
+	for _, v := range h.Viewings {
+		if v.Rating > 0 {
+			sum += v.Rating
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
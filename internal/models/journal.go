@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// Operation 작업 기록의 종류
+type Operation string
+
+const (
+	OperationAdd    Operation = "add"
+	OperationEdit   Operation = "edit"
+	OperationDelete Operation = "delete"
+	OperationImport Operation = "import"
+)
+
+// JournalEntry undo/redo할 수 있는 작업 기록 한 건
+type JournalEntry struct {
+	ID        int       `json:"id" yaml:"id"`
+	Operation Operation `json:"operation" yaml:"operation"`
+	Summary   string    `json:"summary" yaml:"summary"`
+	Entries   int       `json:"entries" yaml:"entries"` // 바뀐 항목 수
+	CreatedAt time.Time `json:"created_at" yaml:"created_at"`
+	UndoneAt  time.Time `json:"undone_at" yaml:"undone_at"` // 되돌리지 않았으면 zero
+}
+
+// Undone 되돌린 작업인지 여부 (redo 대상)
+func (j JournalEntry) Undone() bool {
+	return !j.UndoneAt.IsZero()
+}
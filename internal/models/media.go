@@ -1,20 +1,185 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
 
 type MediaType string
 
+// 기본 제공 타입
+const (
+	Movie       MediaType = "movie"
+	Drama       MediaType = "drama"
+	Anime       MediaType = "anime"
+	Documentary MediaType = "documentary"
+	Variety     MediaType = "variety"
+	Miniseries  MediaType = "miniseries"
+)
+
+// MediaTypeInfo 등록된 미디어 타입의 표시 정보
+type MediaTypeInfo struct {
+	Name     MediaType
+	Label    string // 단수 표시 이름 (예: "Movie")
+	Plural   string // 복수 표시 이름 (예: "Movies")
+	Icon     string // 출력에 쓰는 이모지
+	Episodic bool   // 회차/시즌 진행 상황을 기록하는 타입인지
+}
+
+// 등록 순서가 곧 출력 순서
+var mediaTypes = []MediaTypeInfo{
+	{Movie, "Movie", "Movies", "📽️", false},
+	{Drama, "Drama", "Dramas", "📺", true},
+	{Anime, "Anime", "Anime", "🎌", true},
+	{Documentary, "Documentary", "Documentaries", "🎥", false},
+	{Variety, "Variety show", "Variety shows", "🎤", true},
+	{Miniseries, "Miniseries", "Miniseries", "🎬", true},
+}
+
+var mediaTypeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]*$`)
+
+// RegisterMediaType 사용자 정의 타입 등록 (config.yaml의 types)
+// 이름은 소문자/숫자/-/_ 만 허용하고, 같은 이름이 있으면 표시 정보만 덮어씀
+func RegisterMediaType(info MediaTypeInfo) error {
+	info.Name = MediaType(strings.ToLower(strings.TrimSpace(string(info.Name))))
+	if !mediaTypeNamePattern.MatchString(string(info.Name)) {
+		return fmt.Errorf("invalid media type name %q (use lowercase letters, digits, - or _)", info.Name)
+	}
+
+	fallback := defaultTypeInfo(info.Name)
+	if info.Label == "" {
+		info.Label = fallback.Label
+	}
+	if info.Plural == "" {
+		info.Plural = info.Label + "s"
+	}
+	if info.Icon == "" {
+		info.Icon = fallback.Icon
+	}
+
+	for i, existing := range mediaTypes {
+		if existing.Name == info.Name {
+			mediaTypes[i] = info
+			return nil
+		}
+	}
+	mediaTypes = append(mediaTypes, info)
+	return nil
+}
+
+// MediaTypes 등록된 타입 이름 (출력 순서)
+func MediaTypes() []MediaType {
+	names := make([]MediaType, len(mediaTypes))
+	for i, info := range mediaTypes {
+		names[i] = info.Name
+	}
+	return names
+}
+
+// LookupMediaType 등록된 타입의 표시 정보
+func LookupMediaType(t MediaType) (MediaTypeInfo, bool) {
+	for _, info := range mediaTypes {
+		if info.Name == t {
+			return info, true
+		}
+	}
+	return MediaTypeInfo{}, false
+}
+
+// Info 타입의 표시 정보 (설정에서 빠진 타입도 이름으로 표시)
+func (t MediaType) Info() MediaTypeInfo {
+	if info, ok := LookupMediaType(t); ok {
+		return info
+	}
+	return defaultTypeInfo(t)
+}
+
+// Label 단수 표시 이름
+func (t MediaType) Label() string {
+	return t.Info().Label
+}
+
+func defaultTypeInfo(t MediaType) MediaTypeInfo {
+	label := strings.ReplaceAll(string(t), "_", " ")
+	if label != "" {
+		label = strings.ToUpper(label[:1]) + label[1:]
+	}
+	return MediaTypeInfo{Name: t, Label: label, Plural: label + "s", Icon: "🎞️", Episodic: true}
+}
+
+// ParseMediaType 문자열을 등록된 MediaType으로 변환
+func ParseMediaType(s string) (MediaType, error) {
+	t := MediaType(strings.ToLower(strings.TrimSpace(s)))
+	if _, ok := LookupMediaType(t); ok {
+		return t, nil
+	}
+
+	names := make([]string, len(mediaTypes))
+	for i, info := range mediaTypes {
+		names[i] = string(info.Name)
+	}
+	return "", fmt.Errorf("unknown media type %q (expected one of: %s)", s, strings.Join(names, ", "))
+}
+
+// Status 시청 상태
+type Status string
+
 const (
-	Movie MediaType = "movie"
-	Drama MediaType = "drama"
+	StatusWatching  Status = "watching"
+	StatusCompleted Status = "completed"
+	StatusDropped   Status = "dropped"
+	StatusOnHold    Status = "on-hold"
 )
 
+// Statuses 지원하는 시청 상태 목록
+var Statuses = []Status{StatusWatching, StatusCompleted, StatusDropped, StatusOnHold}
+
+// ParseStatus 문자열을 Status로 변환 (on_hold, onhold, hold도 허용)
+func ParseStatus(s string) (Status, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	switch normalized {
+	case "on_hold", "onhold", "hold", "paused":
+		return StatusOnHold, nil
+	}
+	for _, status := range Statuses {
+		if Status(normalized) == status {
+			return status, nil
+		}
+	}
+	return "", fmt.Errorf("unknown status %q (expected watching, completed, dropped or on-hold)", s)
+}
+
 type MediaEntry struct {
-	ID          int
-	Title       string
-	Type        MediaType
-	Rating      float64
-	Comment     string
-	DateWatched time.Time
-	CreatedAt   time.Time
+	ID              int       `json:"id" yaml:"id"`
+	Title           string    `json:"title" yaml:"title"`
+	Type            MediaType `json:"type" yaml:"type"`
+	Rating          float64   `json:"rating" yaml:"rating"`
+	Comment         string    `json:"comment" yaml:"comment"`
+	Review          string    `json:"review,omitempty" yaml:"review,omitempty"` // 긴 감상문 (Markdown)
+	Tags            []string  `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Status          Status    `json:"status" yaml:"status"`
+	Season          int       `json:"season,omitempty" yaml:"season,omitempty"`                     // 현재 시즌 (0이면 시즌 구분 없음)
+	EpisodesWatched int       `json:"episodes_watched,omitempty" yaml:"episodes_watched,omitempty"` // 현재 시즌에서 본 회차 수
+	TotalEpisodes   int       `json:"total_episodes,omitempty" yaml:"total_episodes,omitempty"`     // 현재 시즌 전체 회차 수 (0이면 모름)
+	DateWatched     time.Time `json:"date_watched" yaml:"date_watched"`
+	CreatedAt       time.Time `json:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" yaml:"updated_at"`
+	DeletedAt       time.Time `json:"deleted_at,omitzero" yaml:"deleted_at,omitempty"` // 휴지통에 있으면 삭제한 시각
+}
+
+// Progress "S2 · 7/16" 형태의 진행 상황 (회차 정보가 없으면 "")
+func (e MediaEntry) Progress() string {
+	var parts []string
+	if e.Season > 0 {
+		parts = append(parts, fmt.Sprintf("S%d", e.Season))
+	}
+	switch {
+	case e.TotalEpisodes > 0:
+		parts = append(parts, fmt.Sprintf("%d/%d", e.EpisodesWatched, e.TotalEpisodes))
+	case e.EpisodesWatched > 0:
+		parts = append(parts, fmt.Sprintf("ep %d", e.EpisodesWatched))
+	}
+	return strings.Join(parts, " · ")
 }
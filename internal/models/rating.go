@@ -0,0 +1,304 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RatingScale 평점 입력/출력 척도
+// DB와 MediaEntry에는 척도와 무관한 정규화 평점(0이면 평가 안 함, 그 외 최고 평점 대비 비율 0~1)을 저장하고
+// 척도는 입력을 정규화하고 출력할 때만 쓰이므로, 척도를 바꾸면 기존 평점도 새 척도로 바로 변환됨
+type RatingScale string
+
+const (
+	ScaleFiveStar     RatingScale = "5-star"    // 0.5~5, 0.5 단위
+	ScaleTenPoint     RatingScale = "10-point"  // 0.5~10, 0.5 단위
+	ScaleHundredPoint RatingScale = "100-point" // 1~100, 1 단위
+	ScaleThumbs       RatingScale = "thumbs"    // 좋아요/싫어요
+)
+
+// RatingScales 지원하는 평점 척도 목록
+var RatingScales = []RatingScale{ScaleFiveStar, ScaleTenPoint, ScaleHundredPoint, ScaleThumbs}
+
+// 싫어요/좋아요의 정규화 평점 (다른 척도에서는 별 1개/5개에 해당)
+const (
+	ThumbsDown = 0.2
+	ThumbsUp   = 1.0
+
+	thumbsUpThreshold = 0.6 // 이 이상이면 좋아요로 표시 (별 3개 이상)
+)
+
+var scaleSteps = map[RatingScale][2]float64{ // 최대값, 단위
+	ScaleFiveStar:     {5, 0.5},
+	ScaleTenPoint:     {10, 0.5},
+	ScaleHundredPoint: {100, 1},
+	ScaleThumbs:       {1, 1},
+}
+
+// ParseRatingScale 문자열을 RatingScale로 변환
+// 이전 설정의 숫자 값(5, 10, 100)도 허용
+func ParseRatingScale(s string) (RatingScale, error) {
+	normalized := strings.ToLower(strings.TrimSpace(s))
+	if n, err := strconv.ParseFloat(normalized, 64); err == nil {
+		switch n {
+		case 5:
+			return ScaleFiveStar, nil
+		case 10:
+			return ScaleTenPoint, nil
+		case 100:
+			return ScaleHundredPoint, nil
+		}
+		return "", fmt.Errorf("unsupported rating scale %q (expected 5, 10 or 100)", s)
+	}
+
+	switch normalized {
+	case "", "stars", "5-stars", "5star", "five-star":
+		return ScaleFiveStar, nil
+	case "10-points", "10point":
+		return ScaleTenPoint, nil
+	case "100-points", "100point", "percent":
+		return ScaleHundredPoint, nil
+	case "thumb", "thumbs-up", "up-down":
+		return ScaleThumbs, nil
+	}
+	for _, scale := range RatingScales {
+		if RatingScale(normalized) == scale {
+			return scale, nil
+		}
+	}
+	return "", fmt.Errorf("unknown rating scale %q (expected 5-star, 10-point, 100-point or thumbs)", s)
+}
+
+func (s RatingScale) MarshalText() ([]byte, error) {
+	return []byte(s), nil
+}
+
+func (s *RatingScale) UnmarshalText(text []byte) error {
+	parsed, err := ParseRatingScale(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// 알 수 없는 척도는 5점 척도로 취급
+func (s RatingScale) steps() (max, step float64) {
+	v, ok := scaleSteps[s]
+	if !ok {
+		v = scaleSteps[ScaleFiveStar]
+	}
+	return v[0], v[1]
+}
+
+// Max 척도의 최고 평점
+func (s RatingScale) Max() float64 {
+	max, _ := s.steps()
+	return max
+}
+
+// Step 척도의 입력 단위 (좋아요/싫어요는 1)
+func (s RatingScale) Step() float64 {
+	_, step := s.steps()
+	return step
+}
+
+// Hint 입력 범위 안내 (예: "0.5-5 in half steps")
+func (s RatingScale) Hint() string {
+	switch s {
+	case ScaleThumbs:
+		return "up or down"
+	case ScaleHundredPoint:
+		return "1-100"
+	case ScaleTenPoint:
+		return "0.5-10 in half steps"
+	default:
+		return "0.5-5 in half steps"
+	}
+}
+
+// Parse 사용자가 입력한 평점을 정규화 평점으로 변환 (0이나 빈 값은 평가 안 함)
+// 숫자 척도는 단위(0.5 등)를 지켜야 함
+func (s RatingScale) Parse(input string) (float64, error) {
+	input = strings.TrimSpace(input)
+	if s == ScaleThumbs {
+		return parseThumbs(input)
+	}
+	if input == "" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseFloat(input, 64)
+	if err != nil {
+		return 0, fmt.Errorf("rating must be a number (%s)", s.Hint())
+	}
+	if _, step := s.steps(); math.Abs(value/step-math.Round(value/step)) > 1e-9 {
+		return 0, fmt.Errorf("rating must be %s", s.Hint())
+	}
+	return s.Normalize(value)
+}
+
+func parseThumbs(input string) (float64, error) {
+	switch strings.ToLower(input) {
+	case "", "0", "none":
+		return 0, nil
+	case "up", "u", "y", "yes", "+", "+1", "1", "👍":
+		return ThumbsUp, nil
+	case "down", "d", "n", "no", "-", "-1", "👎":
+		return ThumbsDown, nil
+	}
+	return 0, fmt.Errorf("rating must be up or down")
+}
+
+// Normalize 척도 값(파일의 rating 등)을 정규화 평점으로 변환 (단위는 검사하지 않음)
+// 좋아요/싫어요 척도의 값은 1(좋아요), -1(싫어요), 0(평가 안 함)
+func (s RatingScale) Normalize(value float64) (float64, error) {
+	if s == ScaleThumbs {
+		switch {
+		case value > 0:
+			return ThumbsUp, nil
+		case value < 0:
+			return ThumbsDown, nil
+		default:
+			return 0, nil
+		}
+	}
+
+	max := s.Max()
+	if value < 0 || value > max {
+		return 0, fmt.Errorf("rating must be between 0 and %s", strconv.FormatFloat(max, 'f', -1, 64))
+	}
+	return value / max, nil
+}
+
+// Value 정규화 평점을 척도 값으로 변환 (Normalize의 역변환)
+func (s RatingScale) Value(rating float64) float64 {
+	if rating <= 0 {
+		return 0
+	}
+	if s == ScaleThumbs {
+		if rating >= thumbsUpThreshold {
+			return 1
+		}
+		return -1
+	}
+	return math.Round(rating*s.Max()*1e4) / 1e4
+}
+
+// Format 정규화 평점을 척도에 맞게 표시 (예: "4.5", "87", "👍")
+func (s RatingScale) Format(rating float64) string {
+	if s == ScaleThumbs {
+		switch s.Value(rating) {
+		case 1:
+			return "👍"
+		case -1:
+			return "👎"
+		default:
+			return "-"
+		}
+	}
+	if _, step := s.steps(); step >= 1 {
+		return fmt.Sprintf("%.0f", s.Value(rating))
+	}
+	return fmt.Sprintf("%.1f", s.Value(rating))
+}
+
+// Average 정규화 평균 평점을 척도 값으로 변환 (좋아요/싫어요 척도는 최고 평점 대비 백분율)
+func (s RatingScale) Average(rating float64) float64 {
+	if s == ScaleThumbs {
+		return math.Round(rating*100*1e2) / 1e2
+	}
+	return math.Round(rating*s.Max()*1e4) / 1e4
+}
+
+// FormatAverage 평균 평점 표시
+func (s RatingScale) FormatAverage(rating float64) string {
+	switch s {
+	case ScaleThumbs:
+		return fmt.Sprintf("%.0f%%", s.Average(rating))
+	case ScaleHundredPoint:
+		return fmt.Sprintf("%.1f", s.Average(rating))
+	default:
+		return fmt.Sprintf("%.2f", s.Average(rating))
+	}
+}
+
+// FormatAverageOutOf "4.12/5" 형태의 평균 평점 (좋아요/싫어요 척도는 백분율만)
+func (s RatingScale) FormatAverageOutOf(rating float64) string {
+	if s == ScaleThumbs {
+		return s.FormatAverage(rating)
+	}
+	return s.FormatAverage(rating) + "/" + strconv.FormatFloat(s.Max(), 'f', -1, 64)
+}
+
+// FormatOutOf "4.5 / 5" 형태 (좋아요/싫어요 척도는 아이콘만)
+func (s RatingScale) FormatOutOf(rating float64) string {
+	if s == ScaleThumbs {
+		return s.Format(rating)
+	}
+	return fmt.Sprintf("%s / %s", s.Format(rating), strconv.FormatFloat(s.Max(), 'f', -1, 64))
+}
+
+// Input 프롬프트 기본값으로 쓸 입력 문자열
+func (s RatingScale) Input(rating float64) string {
+	if s == ScaleThumbs {
+		switch s.Value(rating) {
+		case 1:
+			return "up"
+		case -1:
+			return "down"
+		default:
+			return ""
+		}
+	}
+	return strconv.FormatFloat(s.Value(rating), 'f', -1, 64)
+}
+
+// ScaleEntries 평점을 척도 값으로 바꾼 항목 복사본 (출력용, nil이면 빈 슬라이스)
+func (s RatingScale) ScaleEntries(entries []MediaEntry) []MediaEntry {
+	scaled := make([]MediaEntry, len(entries))
+	for i, entry := range entries {
+		entry.Rating = s.Value(entry.Rating)
+		scaled[i] = entry
+	}
+	return scaled
+}
+
+// Bucket 평점 분포 구간 (정규화 평점, 0은 평가 안 함)
+// 5점/10점 척도는 단위별, 100점 척도는 10점 단위, 좋아요/싫어요는 두 구간
+func (s RatingScale) Bucket(rating float64) float64 {
+	if rating <= 0 {
+		return 0
+	}
+	if s == ScaleThumbs {
+		if rating >= thumbsUpThreshold {
+			return ThumbsUp
+		}
+		return ThumbsDown
+	}
+
+	max, step := s.steps()
+	if s == ScaleHundredPoint {
+		step = 10
+	}
+	bucket := math.Floor(rating*max/step+1e-9) * step
+	bucket = math.Max(step, math.Min(bucket, max))
+	return bucket / max
+}
+
+// BucketLabel 평점 분포 구간 이름 (예: "4.5 stars", "80-89", "👍")
+func (s RatingScale) BucketLabel(rating float64) string {
+	switch s {
+	case ScaleFiveStar:
+		return s.Format(rating) + " stars"
+	case ScaleHundredPoint:
+		if low := s.Value(rating); low < s.Max() {
+			return fmt.Sprintf("%.0f-%.0f", low, low+9)
+		}
+		return s.Format(rating)
+	default:
+		return s.Format(rating)
+	}
+}
@@ -0,0 +1,120 @@
+package models
+
+import "time"
+
+// Stats 컬렉션 통계 (슬라이스는 모두 정렬된 상태)
+type Stats struct {
+	TotalEntries       int            `json:"total_entries" yaml:"total_entries"`
+	TotalTitles        int            `json:"total_titles" yaml:"total_titles"`         // 서로 다른 작품 수 (제목과 타입 기준)
+	RewatchedTitles    int            `json:"rewatched_titles" yaml:"rewatched_titles"` // 두 번 이상 본 작품 수
+	Rewatches          int            `json:"rewatches" yaml:"rewatches"`               // 처음 본 것을 제외한 시청 수
+	AvgRating          float64        `json:"avg_rating" yaml:"avg_rating"`
+	Types              []TypeStats    `json:"types" yaml:"types"`                             // 항목이 있는 타입만, 등록 순서
+	RatingDistribution []RatingBucket `json:"rating_distribution" yaml:"rating_distribution"` // 높은 평점순
+	Yearly             []YearStats    `json:"yearly" yaml:"yearly"`                           // 최근 연도순
+	Tags               []TagStats     `json:"tags" yaml:"tags"`                               // 많이 쓰인 순
+	Watchlist          WatchlistStats `json:"watchlist" yaml:"watchlist"`                     // 볼 예정 목록
+	LastWatched        time.Time      `json:"last_watched" yaml:"last_watched"`               // 항목이 없으면 zero
+}
+
+// TypeStats 타입별 개수와 평균 평점 (평점 0은 평균에서 제외)
+type TypeStats struct {
+	Type      MediaType `json:"type" yaml:"type"`
+	Count     int       `json:"count" yaml:"count"`
+	AvgRating float64   `json:"avg_rating" yaml:"avg_rating"`
+}
+
+// RatingBucket 평점 구간 (RatingScale.Bucket 기준, Rating은 구간 하한의 정규화 평점)
+type RatingBucket struct {
+	Rating     float64 `json:"rating" yaml:"rating"`
+	Count      int     `json:"count" yaml:"count"`
+	Percentage float64 `json:"percentage" yaml:"percentage"`
+}
+
+// YearStats 시청 연도별 통계
+type YearStats struct {
+	Year      int         `json:"year" yaml:"year"`
+	Count     int         `json:"count" yaml:"count"`
+	Types     []TypeStats `json:"types" yaml:"types"`
+	AvgRating float64     `json:"avg_rating" yaml:"avg_rating"`
+}
+
+// TagStats 태그별 항목 수와 평균 평점 (평점 0은 평균에서 제외)
+type TagStats struct {
+	Name      string  `json:"name" yaml:"name"`
+	Count     int     `json:"count" yaml:"count"`
+	AvgRating float64 `json:"avg_rating" yaml:"avg_rating"`
+}
+
+// WatchlistStats 볼 예정 목록 통계
+type WatchlistStats struct {
+	Backlog        int     `json:"backlog" yaml:"backlog"`                     // 아직 보지 않은 항목 수
+	Watched        int     `json:"watched" yaml:"watched"`                     // 목록에서 기록으로 옮긴 항목 수
+	AvgDaysToWatch float64 `json:"avg_days_to_watch" yaml:"avg_days_to_watch"` // 목록에 추가한 뒤 보기까지 평균 일수
+}
+
+// TypeCount 해당 타입의 항목 수
+func (s Stats) TypeCount(t MediaType) int {
+	return typeStats(s.Types, t).Count
+}
+
+// TypeAvgRating 해당 타입의 평균 평점
+func (s Stats) TypeAvgRating(t MediaType) float64 {
+	return typeStats(s.Types, t).AvgRating
+}
+
+// TypeCount 해당 연도에 본 타입의 항목 수
+func (y YearStats) TypeCount(t MediaType) int {
+	return typeStats(y.Types, t).Count
+}
+
+func typeStats(types []TypeStats, t MediaType) TypeStats {
+	for _, ts := range types {
+		if ts.Type == t {
+			return ts
+		}
+	}
+	return TypeStats{Type: t}
+}
+
+// Scaled 정규화 평점을 모두 scale의 값으로 바꾼 복사본 (출력용)
+func (s Stats) Scaled(scale RatingScale) Stats {
+	scaled := s
+	scaled.AvgRating = scale.Average(s.AvgRating)
+	scaled.Types = scaleTypeStats(s.Types, scale)
+
+	scaled.RatingDistribution = make([]RatingBucket, len(s.RatingDistribution))
+	for i, bucket := range s.RatingDistribution {
+		bucket.Rating = scale.Value(bucket.Rating)
+		scaled.RatingDistribution[i] = bucket
+	}
+
+	scaled.Yearly = make([]YearStats, len(s.Yearly))
+	for i, year := range s.Yearly {
+		year.AvgRating = scale.Average(year.AvgRating)
+		year.Types = scaleTypeStats(year.Types, scale)
+		scaled.Yearly[i] = year
+	}
+
+	scaled.Tags = scale.ScaleTags(s.Tags)
+	return scaled
+}
+
+// ScaleTags 평균 평점을 척도 값으로 바꾼 태그 통계 복사본 (출력용)
+func (s RatingScale) ScaleTags(tags []TagStats) []TagStats {
+	scaled := make([]TagStats, len(tags))
+	for i, tag := range tags {
+		tag.AvgRating = s.Average(tag.AvgRating)
+		scaled[i] = tag
+	}
+	return scaled
+}
+
+func scaleTypeStats(types []TypeStats, scale RatingScale) []TypeStats {
+	scaled := make([]TypeStats, len(types))
+	for i, t := range types {
+		t.AvgRating = scale.Average(t.AvgRating)
+		scaled[i] = t
+	}
+	return scaled
+}
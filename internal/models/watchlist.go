@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Priority 볼 예정 목록의 우선순위 (클수록 먼저)
+type Priority int
+
+const (
+	PriorityLow    Priority = 1
+	PriorityNormal Priority = 2
+	PriorityHigh   Priority = 3
+)
+
+// ParsePriority "high"/"normal"/"low" 또는 1~3을 Priority로 변환
+func ParsePriority(s string) (Priority, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "high", "h":
+		return PriorityHigh, nil
+	case "normal", "n", "medium", "":
+		return PriorityNormal, nil
+	case "low", "l":
+		return PriorityLow, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil && n >= int(PriorityLow) && n <= int(PriorityHigh) {
+		return Priority(n), nil
+	}
+	return 0, fmt.Errorf("unknown priority %q (expected high, normal or low)", s)
+}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
+func (p Priority) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Priority) UnmarshalText(text []byte) error {
+	parsed, err := ParsePriority(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+// WatchlistItem 볼 예정인 작품
+type WatchlistItem struct {
+	ID            int       `json:"id" yaml:"id"`
+	Title         string    `json:"title" yaml:"title"`
+	Type          MediaType `json:"type" yaml:"type"`
+	Priority      Priority  `json:"priority" yaml:"priority"`
+	RecommendedBy string    `json:"recommended_by,omitempty" yaml:"recommended_by,omitempty"` // 추천한 사람
+	Note          string    `json:"note,omitempty" yaml:"note,omitempty"`
+	AddedAt       time.Time `json:"added_at" yaml:"added_at"`
+}
@@ -0,0 +1,89 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// 컬럼 비율 및 최소 폭 정의 (list 표와 tui 표가 같은 레이아웃을 씀)
+const (
+	// 컬럼 비율 (합계 1.0)
+	idRatio      = 0.06 // 6%
+	titleRatio   = 0.35 // 35%
+	typeRatio    = 0.08 // 8%
+	ratingRatio  = 0.10 // 10%
+	dateRatio    = 0.16 // 16%
+	commentRatio = 0.25 // 25%
+
+	// 최소 폭
+	minIdWidth      = 4
+	minTitleWidth   = 20
+	minTypeWidth    = 6
+	minRatingWidth  = 8
+	minDateWidth    = 13
+	minCommentWidth = 15
+
+	// 테이블 경계선과 구분자를 위한 여백 (│ 문자 7개)
+	borderSpace = 7
+)
+
+// TableWidths 항목 표의 컬럼별 폭
+type TableWidths struct {
+	ID      int
+	Title   int
+	Type    int
+	Rating  int
+	Date    int
+	Comment int
+}
+
+// CalculateTableWidths 터미널 폭에 맞춘 컬럼 폭 계산 (컬럼마다 최소 폭 보장)
+func CalculateTableWidths(termWidth int) TableWidths {
+	// 실제 내용을 위한 폭
+	contentWidth := termWidth - borderSpace
+
+	return TableWidths{
+		ID:      utils.MaxInt(int(float64(contentWidth)*idRatio), minIdWidth),
+		Title:   utils.MaxInt(int(float64(contentWidth)*titleRatio), minTitleWidth),
+		Type:    utils.MaxInt(int(float64(contentWidth)*typeRatio), minTypeWidth),
+		Rating:  utils.MaxInt(int(float64(contentWidth)*ratingRatio), minRatingWidth),
+		Date:    utils.MaxInt(int(float64(contentWidth)*dateRatio), minDateWidth),
+		Comment: utils.MaxInt(int(float64(contentWidth)*commentRatio), minCommentWidth),
+	}
+}
+
+// EntryField 상세 화면의 한 줄 (라벨과 값)
+type EntryField struct {
+	Label string
+	Value string
+}
+
+// EntryFields show/tui 상세 화면에 표시할 항목 필드 (감상문 제외)
+func EntryFields(entry models.MediaEntry, scale models.RatingScale) []EntryField {
+	fields := []EntryField{
+		{"📌 Title", entry.Title},
+		{"🎞️ Type", entry.Type.Label()},
+		{"⭐ Rating", scale.FormatOutOf(entry.Rating)},
+		{"🗓️ Watched Date", entry.DateWatched.Format("2006-01-02")},
+		{"💬 Comment", entry.Comment},
+	}
+	if entry.Type.Info().Episodic {
+		status := string(entry.Status)
+		if progress := entry.Progress(); progress != "" {
+			status += " · " + progress
+		}
+		fields = append(fields, EntryField{"📺 Status", status})
+	}
+	if len(entry.Tags) > 0 {
+		fields = append(fields, EntryField{"🏷️ Tags", strings.Join(entry.Tags, ", ")})
+	}
+	return fields
+}
+
+// String "라벨 : 값" 형태 (라벨은 labelWidth 폭으로 맞춤)
+func (f EntryField) String(labelWidth int) string {
+	return fmt.Sprintf("%s : %s", utils.PadStringToWidth(f.Label, labelWidth), f.Value)
+}
@@ -0,0 +1,127 @@
+package render
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/transfer"
+)
+
+// Format 명령 출력 형식
+type Format string
+
+const (
+	FormatTable Format = "table" // 기본: 사람이 읽는 표/상자 출력
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatCSV   Format = "csv"
+	FormatTSV   Format = "tsv"
+)
+
+// Formats 지원하는 출력 형식 목록
+var Formats = []Format{FormatTable, FormatJSON, FormatYAML, FormatCSV, FormatTSV}
+
+// ParseFormat 문자열을 출력 형식으로 변환
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	switch f {
+	case "", "text":
+		return FormatTable, nil
+	case "yml":
+		return FormatYAML, nil
+	}
+	for _, known := range Formats {
+		if f == known {
+			return f, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported output format %q (expected table, json, yaml, csv or tsv)", s)
+}
+
+// Structured 표 출력이 아닌 기계 판독용 형식인지
+func (f Format) Structured() bool {
+	return f != FormatTable
+}
+
+// Table CSV/TSV로 출력할 수 있는 데이터
+type Table interface {
+	Header() []string
+	Rows() [][]string
+}
+
+// Render v를 지정한 형식으로 w에 출력 (CSV/TSV는 v가 Table이어야 함)
+func Render(w io.Writer, format Format, v interface{}) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case FormatYAML:
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		return enc.Close()
+	case FormatCSV, FormatTSV:
+		table, ok := v.(Table)
+		if !ok {
+			return fmt.Errorf("%s output is not supported for this command", format)
+		}
+		return writeTable(w, format, table)
+	default:
+		return fmt.Errorf("%s output must be rendered by the command itself", format)
+	}
+}
+
+func writeTable(w io.Writer, format Format, table Table) error {
+	cw := csv.NewWriter(w)
+	if format == FormatTSV {
+		cw.Comma = '\t'
+	}
+
+	if err := cw.Write(table.Header()); err != nil {
+		return err
+	}
+	for _, row := range table.Rows() {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// Entries 항목 목록 (JSON/YAML은 배열, CSV/TSV는 export와 같은 컬럼)
+// 평점은 Scale의 값으로 출력
+type Entries struct {
+	Items []models.MediaEntry
+	Scale models.RatingScale
+}
+
+func (e Entries) Header() []string {
+	return transfer.Columns
+}
+
+func (e Entries) Rows() [][]string {
+	rows := make([][]string, len(e.Items))
+	for i, entry := range e.Items {
+		rows[i] = transfer.NewRecord(entry, e.Scale).Values()
+	}
+	return rows
+}
+
+// JSON 출력 시 빈 목록도 null 대신 []로
+func (e Entries) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Scale.ScaleEntries(e.Items))
+}
+
+func (e Entries) MarshalYAML() (interface{}, error) {
+	return e.Scale.ScaleEntries(e.Items), nil
+}
@@ -0,0 +1,388 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// entryList GET /api/entries 응답
+type entryList struct {
+	Entries []models.MediaEntry `json:"entries"`
+	Total   int                 `json:"total"` // limit/offset과 무관한 전체 개수
+	Limit   int                 `json:"limit,omitempty"`
+	Offset  int                 `json:"offset,omitempty"`
+}
+
+func (s *Server) handleListEntries(w http.ResponseWriter, r *http.Request) {
+	query, err := s.listQuery(r.URL.Query())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	entries, err := s.store.FindEntries(query)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to list entries", err))
+		return
+	}
+	total, err := s.store.CountEntries(query)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to count entries", err))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, entryList{
+		Entries: s.opts.Scale.ScaleEntries(entries),
+		Total:   total,
+		Limit:   query.Limit,
+		Offset:  query.Offset,
+	})
+}
+
+// list 명령의 필터와 같은 쿼리 파라미터를 storage.Query로 변환
+// 평점 범위는 설정된 척도의 값
+func (s *Server) listQuery(params url.Values) (storage.Query, error) {
+	var query storage.Query
+	var err error
+
+	if query.Type, err = optionalType(params.Get("type")); err != nil {
+		return query, err
+	}
+	if query.Year, err = intParam(params, "year"); err != nil {
+		return query, err
+	}
+	if query.From, err = dateParam(params, "from"); err != nil {
+		return query, err
+	}
+	if query.To, err = dateParam(params, "to"); err != nil {
+		return query, err
+	}
+	for _, name := range []string{"min_rating", "max_rating"} {
+		value := params.Get(name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return query, utils.ValidationError(fmt.Sprintf("%s must be a number", name), err)
+		}
+		rating, err := s.opts.Scale.Normalize(n)
+		if err != nil {
+			return query, utils.ValidationError(fmt.Sprintf("Invalid %s: %v", name, err), err)
+		}
+		if name == "min_rating" {
+			query.MinRating = &rating
+		} else {
+			query.MaxRating = &rating
+		}
+	}
+	query.Tags = utils.ParseTags(params["tag"])
+	if status := params.Get("status"); status != "" {
+		if query.Status, err = models.ParseStatus(status); err != nil {
+			return query, utils.ValidationError(err.Error(), err)
+		}
+	}
+	if query.Sort, err = storage.ParseSortField(params.Get("sort")); err != nil {
+		return query, utils.ValidationError(err.Error(), err)
+	}
+	if reverse := params.Get("reverse"); reverse != "" {
+		if query.Reverse, err = strconv.ParseBool(reverse); err != nil {
+			return query, utils.ValidationError("reverse must be true or false", err)
+		}
+	}
+	if query.Limit, err = intParam(params, "limit"); err != nil {
+		return query, err
+	}
+	if query.Offset, err = intParam(params, "offset"); err != nil {
+		return query, err
+	}
+	return query, nil
+}
+
+func (s *Server) handleGetEntry(w http.ResponseWriter, r *http.Request) {
+	entry, err := s.entryFromPath(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, s.scaled(entry))
+}
+
+func (s *Server) handleCreateEntry(w http.ResponseWriter, r *http.Request) {
+	var input entryInput
+	if err := decodeBody(w, r, &input); err != nil {
+		writeError(w, err)
+		return
+	}
+	if input.Title == nil || strings.TrimSpace(*input.Title) == "" {
+		writeError(w, utils.ValidationError("title is required", nil))
+		return
+	}
+	if input.Type == nil {
+		writeError(w, utils.ValidationError("type is required (e.g. movie or drama)", nil))
+		return
+	}
+
+	var entry models.MediaEntry
+	if err := input.apply(&entry, s.opts.Scale); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id, err := s.store.AddEntry(entry)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to save entry", err))
+		return
+	}
+	utils.LogUserAction("api_entry_added", fmt.Sprintf("id: %d, title: %s, type: %s", id, entry.Title, entry.Type))
+
+	created, err := s.store.GetByID(id)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to load the new entry", err))
+		return
+	}
+	w.Header().Set("Location", fmt.Sprintf("/api/entries/%d", id))
+	writeJSON(w, http.StatusCreated, s.scaled(created))
+}
+
+// PATCH: 본문에 있는 필드만 바꿈
+func (s *Server) handleUpdateEntry(w http.ResponseWriter, r *http.Request) {
+	entry, err := s.entryFromPath(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var input entryInput
+	if err := decodeBody(w, r, &input); err != nil {
+		writeError(w, err)
+		return
+	}
+	if err := input.apply(&entry, s.opts.Scale); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := s.store.ReplaceEntry(entry.ID, entry); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			writeError(w, utils.NotFoundError(fmt.Sprintf("No entry found with ID %d", entry.ID), err))
+			return
+		}
+		writeError(w, utils.DatabaseError("Failed to update entry", err))
+		return
+	}
+	utils.LogUserAction("api_entry_updated", fmt.Sprintf("id: %d, title: %s", entry.ID, entry.Title))
+
+	updated, err := s.store.GetByID(entry.ID)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to load the updated entry", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, s.scaled(updated))
+}
+
+func (s *Server) handleDeleteEntry(w http.ResponseWriter, r *http.Request) {
+	id, err := pathID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	deleted, err := s.store.DeleteByIDs([]int{id})
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to delete entry", err))
+		return
+	}
+	if len(deleted) == 0 {
+		writeError(w, utils.NotFoundError(fmt.Sprintf("No entry found with ID %d", id), nil))
+		return
+	}
+	utils.LogUserAction("api_entry_deleted", fmt.Sprintf("id: %d", id))
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// 경로의 {id}로 항목 조회
+func (s *Server) entryFromPath(r *http.Request) (models.MediaEntry, error) {
+	id, err := pathID(r)
+	if err != nil {
+		return models.MediaEntry{}, err
+	}
+
+	entry, err := s.store.GetByID(id)
+	if errors.Is(err, storage.ErrNotFound) {
+		return entry, utils.NotFoundError(fmt.Sprintf("No entry found with ID %d", id), err)
+	}
+	if err != nil {
+		return entry, utils.DatabaseError("Failed to load entry", err)
+	}
+	return entry, nil
+}
+
+func pathID(r *http.Request) (int, error) {
+	id, err := utils.ParseID(r.PathValue("id"))
+	if err != nil || id <= 0 {
+		return 0, utils.ValidationError(fmt.Sprintf("Invalid entry ID %q", r.PathValue("id")), err)
+	}
+	return id, nil
+}
+
+// entryInput 항목 생성/수정 요청 본문 (생략한 필드는 바꾸지 않음)
+type entryInput struct {
+	Title           *string   `json:"title"`
+	Type            *string   `json:"type"`
+	Rating          *float64  `json:"rating"` // 설정된 척도의 값 (좋아요/싫어요는 1/-1, 0은 평가 안 함)
+	Comment         *string   `json:"comment"`
+	Review          *string   `json:"review"`
+	Tags            *[]string `json:"tags"` // 전체 교체 ([]이면 모두 제거)
+	Status          *string   `json:"status"`
+	Season          *int      `json:"season"`
+	EpisodesWatched *int      `json:"episodes_watched"`
+	TotalEpisodes   *int      `json:"total_episodes"`
+	DateWatched     *string   `json:"date_watched"` // YYYY-MM-DD, RFC 3339 또는 yesterday 같은 자연어
+}
+
+// 검증 후 entry에 반영
+func (in entryInput) apply(entry *models.MediaEntry, scale models.RatingScale) error {
+	if in.Title != nil {
+		title := strings.TrimSpace(*in.Title)
+		if title == "" {
+			return utils.ValidationError("title must not be empty", nil)
+		}
+		entry.Title = title
+	}
+	if in.Type != nil {
+		mediaType, err := models.ParseMediaType(*in.Type)
+		if err != nil {
+			return utils.ValidationError(err.Error(), err)
+		}
+		entry.Type = mediaType
+	}
+	if in.Rating != nil {
+		rating, err := scale.Normalize(*in.Rating)
+		if err != nil {
+			return utils.ValidationError(err.Error(), err)
+		}
+		entry.Rating = rating
+	}
+	if in.Comment != nil {
+		entry.Comment = *in.Comment
+	}
+	if in.Review != nil {
+		entry.Review = strings.TrimSpace(*in.Review)
+	}
+	if in.Tags != nil {
+		entry.Tags = utils.ParseTags(*in.Tags)
+	}
+	if in.DateWatched != nil {
+		watched, err := parseDateTime(*in.DateWatched)
+		if err != nil {
+			return err
+		}
+		entry.DateWatched = watched
+	}
+
+	if in.Status != nil {
+		status, err := models.ParseStatus(*in.Status)
+		if err != nil {
+			return utils.ValidationError(err.Error(), err)
+		}
+		entry.Status = status
+	}
+	progress := []struct {
+		name  string
+		value *int
+	}{{"season", in.Season}, {"episodes_watched", in.EpisodesWatched}, {"total_episodes", in.TotalEpisodes}}
+	for _, field := range progress {
+		if field.value == nil {
+			continue
+		}
+		if *field.value < 0 {
+			return utils.ValidationError(field.name+" must not be negative", nil)
+		}
+		if !entry.Type.Info().Episodic {
+			return utils.ValidationError(fmt.Sprintf("%s entries have no episodes to track", entry.Type.Label()), nil)
+		}
+	}
+	if in.Season != nil {
+		entry.Season = *in.Season
+	}
+	if in.EpisodesWatched != nil {
+		entry.EpisodesWatched = *in.EpisodesWatched
+	}
+	if in.TotalEpisodes != nil {
+		entry.TotalEpisodes = *in.TotalEpisodes
+	}
+	return nil
+}
+
+// JSON 본문 읽기 (알 수 없는 필드는 오류)
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		if errors.Is(err, io.EOF) {
+			return utils.ValidationError("Request body must be a JSON object", err)
+		}
+		return utils.ValidationError(fmt.Sprintf("Invalid JSON body: %v", err), err)
+	}
+	return nil
+}
+
+func optionalType(value string) (models.MediaType, error) {
+	if value == "" {
+		return "", nil
+	}
+	mediaType, err := models.ParseMediaType(value)
+	if err != nil {
+		return "", utils.ValidationError(err.Error(), err)
+	}
+	return mediaType, nil
+}
+
+// 0 이상의 정수 파라미터 (없으면 0)
+func intParam(params url.Values, name string) (int, error) {
+	value := params.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, utils.ValidationError(fmt.Sprintf("%s must be a non-negative integer", name), err)
+	}
+	return n, nil
+}
+
+func dateParam(params url.Values, name string) (time.Time, error) {
+	value := params.Get(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := utils.ParseDate(value, time.Now())
+	if err != nil {
+		return time.Time{}, utils.ValidationError(fmt.Sprintf("Invalid %s: %v", name, err), err)
+	}
+	return t, nil
+}
+
+// 시각까지 있는 RFC 3339 또는 날짜 (날짜는 ParseDate 규칙)
+func parseDateTime(value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	t, err := utils.ParseDate(value, time.Now())
+	if err != nil {
+		return time.Time{}, utils.ValidationError(fmt.Sprintf("Invalid date_watched: %v", err), err)
+	}
+	return t, nil
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// Store API가 쓰는 저장소 기능 (*storage.Storage가 구현하므로 CLI와 같은 동작)
+type Store interface {
+	FindEntries(q storage.Query) ([]models.MediaEntry, error)
+	CountEntries(q storage.Query) (int, error)
+	GetByID(id int) (models.MediaEntry, error)
+	FindAllByTitleAndType(title string, mediaType models.MediaType) ([]models.MediaEntry, error)
+	AddEntry(entry models.MediaEntry) (int, error)
+	ReplaceEntry(id int, entry models.MediaEntry) error
+	DeleteByIDs(ids []int) ([]int, error)
+	Search(query string, opts storage.SearchOptions) ([]storage.SearchResult, error)
+	GetStats(scale models.RatingScale) (models.Stats, error)
+}
+
+// Options 서버 설정
+type Options struct {
+	Scale      models.RatingScale // 요청/응답의 rating 척도
+	Token      string             // 비어 있지 않으면 Authorization: Bearer <token> 필요
+	UI         bool               // 읽기 전용 HTML 대시보드도 제공
+	DateFormat string             // 대시보드의 날짜 형식 (비어 있으면 2006-01-02)
+}
+
+// 요청 본문 최대 크기
+const maxBodyBytes = 1 << 20
+
+//go:embed openapi.json
+var openAPIDocument []byte
+
+// Server morama REST API (http.Handler)
+type Server struct {
+	store Store
+	opts  Options
+	mux   *http.ServeMux
+}
+
+// New 저장소와 설정으로 API 핸들러 생성
+func New(store Store, opts Options) *Server {
+	s := &Server{store: store, opts: opts, mux: http.NewServeMux()}
+
+	s.mux.HandleFunc("GET /api/openapi.json", s.handleOpenAPI)
+	s.mux.Handle("GET /api/entries", s.auth(s.handleListEntries))
+	s.mux.Handle("POST /api/entries", s.auth(s.handleCreateEntry))
+	s.mux.Handle("GET /api/entries/{id}", s.auth(s.handleGetEntry))
+	s.mux.Handle("PATCH /api/entries/{id}", s.auth(s.handleUpdateEntry))
+	s.mux.Handle("DELETE /api/entries/{id}", s.auth(s.handleDeleteEntry))
+	s.mux.Handle("GET /api/search", s.auth(s.handleSearch))
+	s.mux.Handle("GET /api/stats", s.auth(s.handleStats))
+	s.mux.HandleFunc("/api/", func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, utils.NotFoundError(fmt.Sprintf("No such endpoint: %s %s", r.Method, r.URL.Path), nil))
+	})
+	if opts.UI {
+		s.registerUI()
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// 토큰이 설정되어 있으면 Bearer 토큰을 확인
+func (s *Server) auth(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.opts.Token != "" {
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || !s.validToken(strings.TrimSpace(token)) {
+				w.Header().Set("WWW-Authenticate", `Bearer realm="morama"`)
+				writeJSON(w, http.StatusUnauthorized, errorResponse{Error: errorBody{
+					Type:    "UNAUTHORIZED",
+					Message: "Missing or invalid bearer token",
+				}})
+				return
+			}
+		}
+		next(w, r)
+	})
+}
+
+func (s *Server) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(openAPIDocument)
+}
+
+// searchResult 검색 결과 한 건 (rank는 작을수록 관련도 높음)
+type searchResult struct {
+	Entry models.MediaEntry `json:"entry"`
+	Rank  float64           `json:"rank"`
+}
+
+func (s *Server) handleSearch(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	q := strings.TrimSpace(params.Get("q"))
+	if q == "" {
+		writeError(w, utils.ValidationError("Query parameter q is required", nil))
+		return
+	}
+
+	opts := storage.SearchOptions{Tags: utils.ParseTags(params["tag"])}
+	var err error
+	if opts.Type, err = optionalType(params.Get("type")); err != nil {
+		writeError(w, err)
+		return
+	}
+	if opts.Limit, err = intParam(params, "limit"); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	results, err := s.store.Search(q, opts)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to search entries", err))
+		return
+	}
+
+	response := make([]searchResult, len(results))
+	for i, result := range results {
+		response[i] = searchResult{Entry: s.scaled(result.Entry), Rank: result.Rank}
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"results": response})
+}
+
+// statsResponse stats 명령의 --output json과 같은 형태
+type statsResponse struct {
+	models.Stats
+	RatingScale models.RatingScale `json:"rating_scale"`
+}
+
+func (s *Server) handleStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := s.store.GetStats(s.opts.Scale)
+	if err != nil {
+		writeError(w, utils.DatabaseError("Failed to compute stats", err))
+		return
+	}
+	writeJSON(w, http.StatusOK, statsResponse{Stats: stats.Scaled(s.opts.Scale), RatingScale: s.opts.Scale})
+}
+
+// 응답용 항목 (평점은 설정된 척도의 값)
+func (s *Server) scaled(entry models.MediaEntry) models.MediaEntry {
+	return s.opts.Scale.ScaleEntries([]models.MediaEntry{entry})[0]
+}
+
+type errorBody struct {
+	Type    utils.ErrorType `json:"type"`
+	Message string          `json:"message"`
+}
+
+type errorResponse struct {
+	Error errorBody `json:"error"`
+}
+
+// StatusCode 에러 종류별 HTTP 상태 코드
+func StatusCode(errType utils.ErrorType) int {
+	switch errType {
+	case utils.ErrorTypeValidation, utils.ErrorTypeUserInput:
+		return http.StatusBadRequest
+	case utils.ErrorTypeNotFound:
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// AppError는 종류에 맞는 상태 코드로, 그 밖의 에러는 500으로 응답
+// DB/시스템 에러의 원인은 응답에 넣지 않고 로그에만 남김
+func writeError(w http.ResponseWriter, err error) {
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		appErr = utils.SystemError("Internal server error", err)
+	}
+	if errors.Is(err, storage.ErrNotFound) && appErr.Type != utils.ErrorTypeNotFound {
+		appErr = utils.NotFoundError(appErr.Message, err)
+	}
+	// 회차 진행 상황 검사는 저장소에서 하므로 저장 에러라도 입력 오류로 응답
+	if errors.Is(err, storage.ErrInvalidProgress) && appErr.Err != nil {
+		appErr = utils.ValidationError(appErr.Err.Error(), err)
+	}
+
+	status := StatusCode(appErr.Type)
+	if status >= http.StatusInternalServerError {
+		utils.Error("API error: %v", appErr)
+	}
+	writeJSON(w, status, errorResponse{Error: errorBody{Type: appErr.Type, Message: appErr.Message}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.Encode(v)
+}
@@ -0,0 +1,263 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+)
+
+const testToken = "s3cret"
+
+// 임시 HOME의 새 DB로 API 서버 생성
+func newTestServer(t *testing.T, opts Options) (*Server, *storage.Storage) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := storage.NewStorage()
+	if err != nil {
+		t.Fatalf("NewStorage: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+
+	if opts.Scale == "" {
+		opts.Scale = models.ScaleFiveStar
+	}
+	return New(store, opts), store
+}
+
+// 요청을 보내고 응답 기록 반환 (body가 비어 있으면 본문 없음)
+func do(t *testing.T, h http.Handler, method, target, body string, header ...string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+		t.Fatalf("decode %q: %v", rec.Body.String(), err)
+	}
+	return v
+}
+
+func assertStatus(t *testing.T, rec *httptest.ResponseRecorder, want int) {
+	t.Helper()
+	if rec.Code != want {
+		t.Fatalf("status = %d, want %d (body %s)", rec.Code, want, rec.Body.String())
+	}
+}
+
+func assertError(t *testing.T, rec *httptest.ResponseRecorder, status int, errType string) {
+	t.Helper()
+	assertStatus(t, rec, status)
+	if got := decode[errorResponse](t, rec).Error.Type; string(got) != errType {
+		t.Errorf("error type = %q, want %q", got, errType)
+	}
+}
+
+func TestAuth(t *testing.T) {
+	srv, _ := newTestServer(t, Options{Token: testToken})
+
+	tests := []struct {
+		name   string
+		header []string
+		want   int
+	}{
+		{"missing", nil, http.StatusUnauthorized},
+		{"wrong token", []string{"Authorization", "Bearer nope"}, http.StatusUnauthorized},
+		{"wrong scheme", []string{"Authorization", "Basic " + testToken}, http.StatusUnauthorized},
+		{"valid", []string{"Authorization", "Bearer " + testToken}, http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := do(t, srv, http.MethodGet, "/api/entries", "", tt.header...)
+			if tt.want == http.StatusUnauthorized {
+				assertError(t, rec, tt.want, "UNAUTHORIZED")
+				if rec.Header().Get("WWW-Authenticate") == "" {
+					t.Error("missing WWW-Authenticate header")
+				}
+				return
+			}
+			assertStatus(t, rec, tt.want)
+		})
+	}
+
+	// 쓰기 요청도 토큰 없이는 거부
+	rec := do(t, srv, http.MethodPost, "/api/entries", `{"title": "Dune", "type": "movie"}`)
+	assertError(t, rec, http.StatusUnauthorized, "UNAUTHORIZED")
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	srv, _ := newTestServer(t, Options{Token: testToken})
+
+	// 문서는 토큰 없이 제공
+	rec := do(t, srv, http.MethodGet, "/api/openapi.json", "")
+	assertStatus(t, rec, http.StatusOK)
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Content-Type = %q, want application/json", ct)
+	}
+	doc := decode[map[string]interface{}](t, rec)
+	if doc["openapi"] != "3.0.3" {
+		t.Errorf("openapi = %v, want 3.0.3", doc["openapi"])
+	}
+	paths, _ := doc["paths"].(map[string]interface{})
+	for _, path := range []string{"/api/entries", "/api/entries/{id}", "/api/search", "/api/stats"} {
+		if _, ok := paths[path]; !ok {
+			t.Errorf("OpenAPI document has no path %s", path)
+		}
+	}
+}
+
+func TestEntryCRUD(t *testing.T) {
+	srv, _ := newTestServer(t, Options{Scale: models.ScaleTenPoint})
+
+	rec := do(t, srv, http.MethodPost, "/api/entries",
+		`{"title": " Dune ", "type": "movie", "rating": 9, "tags": ["Sci-Fi"], "date_watched": "2024-03-01"}`)
+	assertStatus(t, rec, http.StatusCreated)
+	created := decode[models.MediaEntry](t, rec)
+	if created.ID == 0 || created.Title != "Dune" || created.Type != models.Movie {
+		t.Fatalf("created = %+v", created)
+	}
+	if created.Rating != 9 {
+		t.Errorf("rating = %v, want 9 on the 10-point scale", created.Rating)
+	}
+	if want := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC); !created.DateWatched.Equal(want) {
+		t.Errorf("date_watched = %v, want %v", created.DateWatched, want)
+	}
+	location := rec.Header().Get("Location")
+	if location != "/api/entries/1" {
+		t.Errorf("Location = %q, want /api/entries/1", location)
+	}
+
+	rec = do(t, srv, http.MethodGet, location, "")
+	assertStatus(t, rec, http.StatusOK)
+	if got := decode[models.MediaEntry](t, rec); got.Title != "Dune" || len(got.Tags) != 1 {
+		t.Errorf("get = %+v", got)
+	}
+
+	rec = do(t, srv, http.MethodPatch, location, `{"rating": 7, "comment": "Loud"}`)
+	assertStatus(t, rec, http.StatusOK)
+	updated := decode[models.MediaEntry](t, rec)
+	if updated.Rating != 7 || updated.Comment != "Loud" || updated.Title != "Dune" {
+		t.Errorf("updated = %+v, want rating 7, comment Loud, title unchanged", updated)
+	}
+
+	rec = do(t, srv, http.MethodPost, "/api/entries", `{"title": "Frieren", "type": "anime", "rating": 10}`)
+	assertStatus(t, rec, http.StatusCreated)
+
+	rec = do(t, srv, http.MethodGet, "/api/entries?type=movie", "")
+	assertStatus(t, rec, http.StatusOK)
+	list := decode[entryList](t, rec)
+	if list.Total != 1 || len(list.Entries) != 1 || list.Entries[0].Title != "Dune" {
+		t.Errorf("list ?type=movie = %+v", list)
+	}
+	rec = do(t, srv, http.MethodGet, "/api/entries?min_rating=8&limit=1", "")
+	assertStatus(t, rec, http.StatusOK)
+	list = decode[entryList](t, rec)
+	if list.Total != 1 || list.Limit != 1 || list.Entries[0].Title != "Frieren" {
+		t.Errorf("list ?min_rating=8 = %+v", list)
+	}
+
+	rec = do(t, srv, http.MethodDelete, location, "")
+	assertStatus(t, rec, http.StatusNoContent)
+	if rec.Body.Len() != 0 {
+		t.Errorf("delete body = %q, want empty", rec.Body.String())
+	}
+	rec = do(t, srv, http.MethodGet, location, "")
+	assertError(t, rec, http.StatusNotFound, "NOT_FOUND")
+}
+
+func TestErrors(t *testing.T) {
+	srv, store := newTestServer(t, Options{})
+	id, err := store.AddEntry(models.MediaEntry{Title: "Dune", Type: models.Movie, Rating: 0.8})
+	if err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+	entry := fmt.Sprintf("/api/entries/%d", id)
+	showID, err := store.AddEntry(models.MediaEntry{Title: "Frieren", Type: models.Anime, Season: 1, TotalEpisodes: 28})
+	if err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+	show := fmt.Sprintf("/api/entries/%d", showID)
+
+	tests := []struct {
+		name, method, target, body string
+		status                     int
+		errType                    string
+	}{
+		{"unknown entry", http.MethodGet, "/api/entries/999", "", http.StatusNotFound, "NOT_FOUND"},
+		{"update unknown entry", http.MethodPatch, "/api/entries/999", `{"rating": 3}`, http.StatusNotFound, "NOT_FOUND"},
+		{"delete unknown entry", http.MethodDelete, "/api/entries/999", "", http.StatusNotFound, "NOT_FOUND"},
+		{"unknown endpoint", http.MethodGet, "/api/nope", "", http.StatusNotFound, "NOT_FOUND"},
+		{"bad id", http.MethodGet, "/api/entries/abc", "", http.StatusBadRequest, "VALIDATION"},
+		{"missing title", http.MethodPost, "/api/entries", `{"type": "movie"}`, http.StatusBadRequest, "VALIDATION"},
+		{"missing type", http.MethodPost, "/api/entries", `{"title": "Dune"}`, http.StatusBadRequest, "VALIDATION"},
+		{"empty body", http.MethodPost, "/api/entries", "", http.StatusBadRequest, "VALIDATION"},
+		{"unknown field", http.MethodPost, "/api/entries", `{"title": "Dune", "type": "movie", "score": 5}`, http.StatusBadRequest, "VALIDATION"},
+		{"bad rating", http.MethodPatch, entry, `{"rating": 7}`, http.StatusBadRequest, "VALIDATION"},
+		{"episodes on a movie", http.MethodPatch, entry, `{"episodes_watched": 3}`, http.StatusBadRequest, "VALIDATION"},
+		{"episodes past the total", http.MethodPatch, show, `{"episodes_watched": 30}`, http.StatusBadRequest, "VALIDATION"},
+		{"bad date", http.MethodPatch, entry, `{"date_watched": "someday"}`, http.StatusBadRequest, "VALIDATION"},
+		{"bad list filter", http.MethodGet, "/api/entries?limit=-1", "", http.StatusBadRequest, "VALIDATION"},
+		{"bad sort", http.MethodGet, "/api/entries?sort=color", "", http.StatusBadRequest, "VALIDATION"},
+		{"search without q", http.MethodGet, "/api/search", "", http.StatusBadRequest, "VALIDATION"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assertError(t, do(t, srv, tt.method, tt.target, tt.body), tt.status, tt.errType)
+		})
+	}
+
+	// 실패한 요청은 항목을 바꾸지 않음
+	got, err := store.GetByID(id)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.Rating != 0.8 || got.EpisodesWatched != 0 {
+		t.Errorf("entry changed by failed requests: %+v", got)
+	}
+}
+
+func TestSearchAndStats(t *testing.T) {
+	srv, store := newTestServer(t, Options{})
+	for _, entry := range []models.MediaEntry{
+		{Title: "Dune", Type: models.Movie, Rating: 0.8, Comment: "sand everywhere"},
+		{Title: "Frieren", Type: models.Anime, Rating: 1},
+	} {
+		if _, err := store.AddEntry(entry); err != nil {
+			t.Fatalf("AddEntry: %v", err)
+		}
+	}
+
+	rec := do(t, srv, http.MethodGet, "/api/search?q=sand", "")
+	assertStatus(t, rec, http.StatusOK)
+	results := decode[struct {
+		Results []searchResult `json:"results"`
+	}](t, rec).Results
+	if len(results) != 1 || results[0].Entry.Title != "Dune" || results[0].Entry.Rating != 4 {
+		t.Errorf("search results = %+v, want Dune rated 4", results)
+	}
+
+	rec = do(t, srv, http.MethodGet, "/api/stats", "")
+	assertStatus(t, rec, http.StatusOK)
+	stats := decode[map[string]interface{}](t, rec)
+	if stats["total_entries"] != float64(2) || stats["rating_scale"] != "5-star" {
+		t.Errorf("stats total_entries = %v, rating_scale = %v", stats["total_entries"], stats["rating_scale"])
+	}
+}
@@ -0,0 +1,449 @@
+package server
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"embed"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/render"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// 대시보드 템플릿과 정적 파일 (CDN 없이 바이너리에 포함)
+//
+//go:embed ui
+var uiFiles embed.FS
+
+var uiTemplates = template.Must(template.ParseFS(uiFiles, "ui/*.html"))
+
+const (
+	tokenCookie = "morama_token" // 대시보드가 ?token=으로 받은 토큰을 저장하는 쿠키
+	typeColors  = 8              // style.css의 .t0 ~ .t7
+)
+
+// 대시보드 경로 등록 (읽기 전용)
+func (s *Server) registerUI() {
+	static, _ := fs.Sub(uiFiles, "ui")
+	s.mux.Handle("GET /static/", http.FileServerFS(static))
+	s.mux.Handle("GET /{$}", s.uiAuth(s.handleDashboard))
+	s.mux.Handle("GET /entries/{id}", s.uiAuth(s.handleTitlePage))
+}
+
+// 토큰이 설정되어 있으면 헤더나 쿠키의 토큰을 확인
+// ?token=으로 맞는 토큰이 오면 쿠키에 저장하고 토큰을 뺀 주소로 이동
+func (s *Server) uiAuth(next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if s.opts.Token == "" || s.authorized(r) {
+			next(w, r)
+			return
+		}
+
+		params := r.URL.Query()
+		if token := params.Get("token"); token != "" && s.validToken(token) {
+			http.SetCookie(w, &http.Cookie{
+				Name:     tokenCookie,
+				Value:    token,
+				Path:     "/",
+				HttpOnly: true,
+				SameSite: http.SameSiteStrictMode,
+			})
+			params.Del("token")
+			target := r.URL.Path
+			if len(params) > 0 {
+				target += "?" + params.Encode()
+			}
+			http.Redirect(w, r, target, http.StatusSeeOther)
+			return
+		}
+
+		s.renderError(w, http.StatusUnauthorized, "Unauthorized",
+			"This dashboard needs the server token. Open it with ?token=<server.token from config.yaml>.")
+	})
+}
+
+// Authorization 헤더 또는 대시보드 쿠키의 토큰이 맞는지 여부
+func (s *Server) authorized(r *http.Request) bool {
+	if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok && s.validToken(strings.TrimSpace(token)) {
+		return true
+	}
+	cookie, err := r.Cookie(tokenCookie)
+	return err == nil && s.validToken(cookie.Value)
+}
+
+func (s *Server) validToken(token string) bool {
+	return subtle.ConstantTimeCompare([]byte(token), []byte(s.opts.Token)) == 1
+}
+
+// dashboardPage 대시보드 화면 데이터 (표시용 문자열로 미리 변환)
+type dashboardPage struct {
+	Summary   []render.EntryField
+	Legend    []typeBar
+	Years     []yearBar
+	Histogram []histogramBar
+	Filter    filterForm
+	Columns   []tableColumn
+	Rows      []tableRow
+	Total     int
+}
+
+// yearBar 연도별 차트의 한 줄 (stats의 Yearly Breakdown과 같은 데이터)
+type yearBar struct {
+	Year     int
+	Count    int
+	Average  string
+	Link     string
+	Segments []typeBar
+}
+
+// typeBar 타입별 막대 조각 (Width는 가장 많이 본 해 대비 백분율)
+type typeBar struct {
+	Label string
+	Count int
+	Class string
+	Width string
+}
+
+// histogramBar 평점 분포의 한 구간
+type histogramBar struct {
+	Label      string
+	Count      int
+	Percentage string
+	Width      string
+}
+
+type filterForm struct {
+	Types  []filterOption
+	Year   string
+	Tag    string
+	Sort   string
+	Active bool
+}
+
+type filterOption struct {
+	Value    string
+	Label    string
+	Selected bool
+}
+
+// tableColumn 표 머리 (Link가 있으면 정렬 가능)
+type tableColumn struct {
+	Label string
+	Link  string
+	Arrow string
+}
+
+type tableRow struct {
+	ID      int
+	Link    string
+	Title   string
+	Type    string
+	Rating  string
+	Date    string
+	Comment string
+	Tags    string
+}
+
+func (s *Server) handleDashboard(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query, err := s.listQuery(params)
+	if err != nil {
+		s.renderAppError(w, err)
+		return
+	}
+
+	stats, err := s.store.GetStats(s.opts.Scale)
+	if err != nil {
+		s.renderAppError(w, utils.DatabaseError("Failed to compute stats", err))
+		return
+	}
+	entries, err := s.store.FindEntries(query)
+	if err != nil {
+		s.renderAppError(w, utils.DatabaseError("Failed to list entries", err))
+		return
+	}
+
+	page := dashboardPage{
+		Summary:   s.summary(stats),
+		Legend:    typeLegend(stats.Types),
+		Years:     yearBars(stats.Yearly, s.opts.Scale),
+		Histogram: histogramBars(stats.RatingDistribution, s.opts.Scale),
+		Filter:    newFilterForm(params, query),
+		Columns:   tableColumns(params, query),
+		Total:     stats.TotalEntries,
+	}
+	for _, entry := range entries {
+		page.Rows = append(page.Rows, tableRow{
+			ID:      entry.ID,
+			Link:    fmt.Sprintf("/entries/%d", entry.ID),
+			Title:   entry.Title,
+			Type:    entry.Type.Label(),
+			Rating:  s.opts.Scale.Format(entry.Rating),
+			Date:    entry.DateWatched.Format(s.dateFormat()),
+			Comment: entry.Comment,
+			Tags:    strings.Join(entry.Tags, ", "),
+		})
+	}
+	s.renderPage(w, "dashboard", "morama", page)
+}
+
+// 요약 카드 (stats 명령의 첫 부분)
+func (s *Server) summary(stats models.Stats) []render.EntryField {
+	fields := []render.EntryField{
+		{Label: "Entries", Value: strconv.Itoa(stats.TotalEntries)},
+		{Label: "Titles", Value: strconv.Itoa(stats.TotalTitles)},
+	}
+	if stats.TotalEntries > 0 {
+		fields = append(fields, render.EntryField{Label: "Average rating", Value: s.opts.Scale.FormatAverageOutOf(stats.AvgRating)})
+	}
+	if stats.Rewatches > 0 {
+		fields = append(fields, render.EntryField{Label: "Rewatches", Value: strconv.Itoa(stats.Rewatches)})
+	}
+	if stats.Watchlist.Backlog > 0 {
+		fields = append(fields, render.EntryField{Label: "Watchlist", Value: strconv.Itoa(stats.Watchlist.Backlog)})
+	}
+	if !stats.LastWatched.IsZero() {
+		fields = append(fields, render.EntryField{Label: "Last watched", Value: stats.LastWatched.Format(s.dateFormat())})
+	}
+	return fields
+}
+
+// 타입별 색 (등록 순서 기준이라 연도가 달라도 같은 색)
+func typeClass(t models.MediaType) string {
+	for i, name := range models.MediaTypes() {
+		if name == t {
+			return fmt.Sprintf("t%d", i%typeColors)
+		}
+	}
+	return fmt.Sprintf("t%d", typeColors-1)
+}
+
+func typeLegend(types []models.TypeStats) []typeBar {
+	legend := make([]typeBar, len(types))
+	for i, t := range types {
+		legend[i] = typeBar{Label: t.Type.Info().Plural, Count: t.Count, Class: typeClass(t.Type)}
+	}
+	return legend
+}
+
+func yearBars(years []models.YearStats, scale models.RatingScale) []yearBar {
+	most := 0
+	for _, year := range years {
+		most = max(most, year.Count)
+	}
+
+	bars := make([]yearBar, len(years))
+	for i, year := range years {
+		bar := yearBar{
+			Year:    year.Year,
+			Count:   year.Count,
+			Average: scale.FormatAverage(year.AvgRating),
+			Link:    "/?" + url.Values{"year": {strconv.Itoa(year.Year)}}.Encode(),
+		}
+		for _, t := range year.Types {
+			bar.Segments = append(bar.Segments, typeBar{
+				Label: t.Type.Info().Plural,
+				Count: t.Count,
+				Class: typeClass(t.Type),
+				Width: percent(t.Count, most),
+			})
+		}
+		bars[i] = bar
+	}
+	return bars
+}
+
+func histogramBars(buckets []models.RatingBucket, scale models.RatingScale) []histogramBar {
+	most := 0
+	for _, bucket := range buckets {
+		most = max(most, bucket.Count)
+	}
+
+	bars := make([]histogramBar, len(buckets))
+	for i, bucket := range buckets {
+		bars[i] = histogramBar{
+			Label:      scale.BucketLabel(bucket.Rating),
+			Count:      bucket.Count,
+			Percentage: fmt.Sprintf("%.1f%%", bucket.Percentage),
+			Width:      percent(bucket.Count, most),
+		}
+	}
+	return bars
+}
+
+// 막대 폭 (CSS width의 % 값)
+func percent(n, total int) string {
+	if total == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(float64(n)*100/float64(total), 'f', 1, 64)
+}
+
+func newFilterForm(params url.Values, query storage.Query) filterForm {
+	form := filterForm{
+		Year:   params.Get("year"),
+		Tag:    strings.Join(query.Tags, ","),
+		Sort:   params.Get("sort"),
+		Active: query.Type != "" || query.Year != 0 || len(query.Tags) > 0,
+	}
+	form.Types = append(form.Types, filterOption{Value: "", Label: "All types"})
+	for _, t := range models.MediaTypes() {
+		form.Types = append(form.Types, filterOption{Value: string(t), Label: t.Info().Plural, Selected: t == query.Type})
+	}
+	return form
+}
+
+// 정렬 가능한 열은 누르면 그 기준으로 정렬하고, 이미 그 기준이면 방향을 뒤집음
+func tableColumns(params url.Values, query storage.Query) []tableColumn {
+	sortable := map[string]storage.SortField{
+		"ID": storage.SortID, "Title": storage.SortTitle, "Rating": storage.SortRating, "Date": storage.SortDate,
+	}
+
+	var columns []tableColumn
+	for _, label := range []string{"ID", "Title", "Type", "Rating", "Date", "Comment", "Tags"} {
+		column := tableColumn{Label: label}
+		if field, ok := sortable[label]; ok {
+			link := url.Values{}
+			for name, values := range params {
+				link[name] = values
+			}
+			link.Set("sort", string(field))
+			link.Del("reverse")
+			if field == query.Sort {
+				if !query.Reverse {
+					link.Set("reverse", "true")
+				}
+				// 제목만 기본이 오름차순
+				if (field == storage.SortTitle) != query.Reverse {
+					column.Arrow = "▲"
+				} else {
+					column.Arrow = "▼"
+				}
+			}
+			column.Link = "/?" + link.Encode()
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+// titlePage 작품 상세 화면 (같은 제목과 타입의 시청 기록 전체)
+type titlePage struct {
+	Title    string
+	Fields   []render.EntryField
+	Viewings []viewing
+}
+
+type viewing struct {
+	ID       int
+	Date     string
+	Rating   string
+	Comment  string
+	Review   string
+	Selected bool
+}
+
+func (s *Server) handleTitlePage(w http.ResponseWriter, r *http.Request) {
+	entry, err := s.entryFromPath(r)
+	if err != nil {
+		s.renderAppError(w, err)
+		return
+	}
+	entries, err := s.store.FindAllByTitleAndType(entry.Title, entry.Type)
+	if err != nil {
+		s.renderAppError(w, utils.DatabaseError("Failed to load viewings", err))
+		return
+	}
+	history := models.GroupViewings(entries)[0]
+	latest := history.Latest()
+
+	page := titlePage{Title: history.Title}
+	page.Fields = append(page.Fields,
+		render.EntryField{Label: "Type", Value: history.Type.Label()},
+		render.EntryField{Label: "Viewings", Value: strconv.Itoa(len(history.Viewings))},
+		render.EntryField{Label: "Average rating", Value: s.opts.Scale.FormatAverageOutOf(history.AvgRating())},
+	)
+	if history.Type.Info().Episodic {
+		status := string(latest.Status)
+		if progress := latest.Progress(); progress != "" {
+			status += " · " + progress
+		}
+		page.Fields = append(page.Fields, render.EntryField{Label: "Status", Value: status})
+	}
+	if len(latest.Tags) > 0 {
+		page.Fields = append(page.Fields, render.EntryField{Label: "Tags", Value: strings.Join(latest.Tags, ", ")})
+	}
+
+	for _, v := range history.Viewings {
+		page.Viewings = append(page.Viewings, viewing{
+			ID:       v.ID,
+			Date:     v.DateWatched.Format(s.dateFormat()),
+			Rating:   s.opts.Scale.FormatOutOf(v.Rating),
+			Comment:  v.Comment,
+			Review:   v.Review,
+			Selected: v.ID == entry.ID,
+		})
+	}
+	s.renderPage(w, "title", history.Title+" · morama", page)
+}
+
+func (s *Server) dateFormat() string {
+	if s.opts.DateFormat == "" {
+		return "2006-01-02"
+	}
+	return s.opts.DateFormat
+}
+
+// layout.html이 받는 데이터
+type pageData struct {
+	Title string
+	Page  interface{}
+}
+
+// 템플릿을 버퍼에 그린 뒤 응답 (실패하면 500)
+func (s *Server) renderPage(w http.ResponseWriter, name, title string, page interface{}) {
+	s.render(w, http.StatusOK, name, pageData{Title: title, Page: page})
+}
+
+func (s *Server) render(w http.ResponseWriter, status int, name string, data pageData) {
+	var buf bytes.Buffer
+	if err := uiTemplates.ExecuteTemplate(&buf, name, data); err != nil {
+		utils.Error("Dashboard template error: %v", err)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(status)
+	buf.WriteTo(w)
+}
+
+type errorPage struct {
+	Status  int
+	Message string
+}
+
+func (s *Server) renderError(w http.ResponseWriter, status int, title, message string) {
+	s.render(w, status, "error", pageData{Title: title + " · morama", Page: errorPage{Status: status, Message: message}})
+}
+
+// API의 writeError와 같은 상태 코드로 에러 화면 출력
+func (s *Server) renderAppError(w http.ResponseWriter, err error) {
+	var appErr *utils.AppError
+	if !errors.As(err, &appErr) {
+		appErr = utils.SystemError("Internal server error", err)
+	}
+	status := StatusCode(appErr.Type)
+	if status >= http.StatusInternalServerError {
+		utils.Error("Dashboard error: %v", appErr)
+	}
+	s.renderError(w, status, http.StatusText(status), appErr.Message)
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"flag"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// 스냅샷용 고정 데이터 (시청일/생성일을 그대로 가져오는 ImportEntries 사용)
+func importFixture(t *testing.T, store *storage.Storage) {
+	t.Helper()
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 20, 0, 0, 0, time.UTC)
+	}
+	entries := []models.MediaEntry{
+		{Title: "Past Lives", Type: models.Movie, Rating: 0.9, Comment: "Quietly devastating", DateWatched: date(2023, 6, 2), Tags: []string{"romance"}},
+		{Title: "Dune", Type: models.Movie, Rating: 0.8, Comment: "Sand <everywhere>", DateWatched: date(2024, 3, 1), Tags: []string{"sci-fi", "imax"}},
+		{Title: "Dune", Type: models.Movie, Rating: 1, Comment: "Better the second time", DateWatched: date(2024, 11, 20), Tags: []string{"sci-fi", "imax"}, Review: "The sound design alone."},
+		{Title: "Frieren", Type: models.Anime, Rating: 1, DateWatched: date(2024, 1, 15), Status: models.StatusWatching, Season: 1, EpisodesWatched: 12, TotalEpisodes: 28},
+		{Title: "Our Planet", Type: models.Documentary, Rating: 0.6, DateWatched: date(2023, 12, 24)},
+	}
+	for i := range entries {
+		entries[i].CreatedAt = entries[i].DateWatched
+	}
+	if _, err := store.ImportEntries(entries); err != nil {
+		t.Fatalf("ImportEntries: %v", err)
+	}
+}
+
+// body가 testdata/name과 같은지 확인 (-update면 다시 씀)
+func assertGolden(t *testing.T, name string, body []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, body, 0644); err != nil {
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run go test -update to create it)", path, err)
+	}
+	if string(body) != string(want) {
+		t.Errorf("%s does not match the rendered page (run go test -update to accept):\n%s", path, body)
+	}
+}
+
+func TestDashboardSnapshot(t *testing.T) {
+	srv, store := newTestServer(t, Options{UI: true})
+	importFixture(t, store)
+
+	tests := []struct {
+		golden, target string
+	}{
+		{"dashboard.golden.html", "/"},
+		{"dashboard_filtered.golden.html", "/?type=movie&sort=rating"},
+		{"title.golden.html", "/entries/2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.golden, func(t *testing.T) {
+			rec := do(t, srv, http.MethodGet, tt.target, "")
+			assertStatus(t, rec, http.StatusOK)
+			if ct := rec.Header().Get("Content-Type"); ct != "text/html; charset=utf-8" {
+				t.Errorf("Content-Type = %q", ct)
+			}
+			assertGolden(t, tt.golden, rec.Body.Bytes())
+		})
+	}
+}
+
+func TestDashboardErrors(t *testing.T) {
+	srv, _ := newTestServer(t, Options{UI: true})
+
+	assertStatus(t, do(t, srv, http.MethodGet, "/entries/999", ""), http.StatusNotFound)
+	assertStatus(t, do(t, srv, http.MethodGet, "/?year=abc", ""), http.StatusBadRequest)
+	assertStatus(t, do(t, srv, http.MethodGet, "/static/style.css", ""), http.StatusOK)
+
+	// --ui 없이는 대시보드 경로가 없음
+	apiOnly, _ := newTestServer(t, Options{})
+	assertStatus(t, do(t, apiOnly, http.MethodGet, "/", ""), http.StatusNotFound)
+}
+
+func TestDashboardIsReadOnly(t *testing.T) {
+	srv, store := newTestServer(t, Options{UI: true})
+	importFixture(t, store)
+
+	for _, method := range []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete} {
+		for _, target := range []string{"/", "/entries/2", "/static/style.css"} {
+			rec := do(t, srv, method, target, `{"rating": 1}`)
+			if rec.Code != http.StatusMethodNotAllowed {
+				t.Errorf("%s %s: status = %d, want 405", method, target, rec.Code)
+			}
+		}
+	}
+
+	entry, err := store.GetByID(2)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if entry.Rating != 0.8 {
+		t.Errorf("rating changed to %v through the dashboard", entry.Rating)
+	}
+}
+
+func TestDashboardAuth(t *testing.T) {
+	srv, store := newTestServer(t, Options{UI: true, Token: testToken})
+	importFixture(t, store)
+	cookie := tokenCookie + "=" + testToken
+
+	for _, target := range []string{"/", "/entries/2", "/?token=nope"} {
+		assertStatus(t, do(t, srv, http.MethodGet, target, ""), http.StatusUnauthorized)
+	}
+	assertStatus(t, do(t, srv, http.MethodGet, "/", "", "Cookie", tokenCookie+"=nope"), http.StatusUnauthorized)
+
+	// ?token=은 쿠키를 남기고 토큰을 뺀 주소로 이동
+	rec := do(t, srv, http.MethodGet, "/?year=2024&token="+testToken, "")
+	assertStatus(t, rec, http.StatusSeeOther)
+	if location := rec.Header().Get("Location"); location != "/?year=2024" {
+		t.Errorf("Location = %q, want /?year=2024", location)
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != tokenCookie || !cookies[0].HttpOnly {
+		t.Fatalf("cookies = %+v, want an HttpOnly %s cookie", cookies, tokenCookie)
+	}
+
+	assertStatus(t, do(t, srv, http.MethodGet, "/", "", "Cookie", cookie), http.StatusOK)
+	assertStatus(t, do(t, srv, http.MethodGet, "/entries/2", "", "Authorization", "Bearer "+testToken), http.StatusOK)
+
+	// 대시보드 쿠키로는 API를 쓸 수 없음
+	assertStatus(t, do(t, srv, http.MethodGet, "/api/entries", "", "Cookie", cookie), http.StatusUnauthorized)
+	assertStatus(t, do(t, srv, http.MethodDelete, "/api/entries/2", "", "Cookie", cookie), http.StatusUnauthorized)
+	assertStatus(t, do(t, srv, http.MethodDelete, "/entries/2", "", "Cookie", cookie), http.StatusMethodNotAllowed)
+	if _, err := store.GetByID(2); err != nil {
+		t.Errorf("entry 2 gone after requests with the dashboard cookie: %v", err)
+	}
+}
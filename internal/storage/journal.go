@@ -0,0 +1,282 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// 한 번에 IN (...)으로 조회할 ID 수
+const idBatchSize = 500
+
+// 트랜잭션 안에서 ID 목록의 항목을 ID순으로 읽음 (없는 ID는 건너뜀)
+func entriesByIDs(tx *sql.Tx, ids []int) ([]models.MediaEntry, error) {
+	var entries []models.MediaEntry
+	for start := 0; start < len(ids); start += idBatchSize {
+		batch := ids[start:min(start+idBatchSize, len(ids))]
+		args := make([]interface{}, len(batch))
+		for i, id := range batch {
+			args[i] = id
+		}
+		in := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(batch)), ", ") + ")"
+
+		found, err := queryEntriesIn(tx, `SELECT `+entryColumns+` FROM media WHERE id IN `+in, args...)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, found...)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].ID < entries[j].ID })
+	return entries, nil
+}
+
+// 작업을 journal에 기록 (before는 작업 전 항목, afterIDs 항목은 작업 후 상태를 읽어 저장)
+// 새 작업을 기록하면 되돌린 작업은 더 이상 redo할 수 없으므로 지움
+func recordOperation(tx *sql.Tx, op models.Operation, summary string, before []models.MediaEntry, afterIDs []int) error {
+	_, err := insertJournal(tx, op, summary, before, afterIDs)
+	return err
+}
+
+// recordOperation과 같지만 기록한 작업의 ID 반환 (기록할 항목이 없으면 0)
+func insertJournal(tx *sql.Tx, op models.Operation, summary string, before []models.MediaEntry, afterIDs []int) (int64, error) {
+	after, err := entriesByIDs(tx, afterIDs)
+	if err != nil {
+		return 0, err
+	}
+	if len(before) == 0 && len(after) == 0 {
+		return 0, nil
+	}
+
+	beforeImage, err := json.Marshal(nonNil(before))
+	if err != nil {
+		return 0, err
+	}
+	afterImage, err := json.Marshal(nonNil(after))
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM journal WHERE undone_at IS NOT NULL`); err != nil {
+		return 0, err
+	}
+	result, err := tx.Exec(`
+	INSERT INTO journal (operation, summary, entry_count, before_image, after_image, created_at)
+	VALUES (?, ?, ?, ?, ?, ?)
+	`, string(op), summary, max(len(before), len(after)), string(beforeImage), string(afterImage), formatTime(time.Now()))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func nonNil(entries []models.MediaEntry) []models.MediaEntry {
+	if entries == nil {
+		return []models.MediaEntry{}
+	}
+	return entries
+}
+
+// 한 건짜리 작업 설명에 쓰는 항목 이름 (예: "[12] Frieren")
+func entryLabel(entry models.MediaEntry) string {
+	return fmt.Sprintf("[%d] %s", entry.ID, entry.Title)
+}
+
+// 여러 항목 작업의 설명 (한 건이면 항목 이름)
+func entriesSummary(verb string, entries []models.MediaEntry) string {
+	if len(entries) == 1 {
+		return fmt.Sprintf("%s %s", verb, entryLabel(entries[0]))
+	}
+	return fmt.Sprintf("%s %d entries", verb, len(entries))
+}
+
+// History 최근 작업 기록 (최신순, limit이 0이면 전체)
+func (s *Storage) History(limit int) ([]models.JournalEntry, error) {
+	query := `SELECT id, operation, summary, entry_count, created_at, undone_at FROM journal ORDER BY id DESC`
+	var args []interface{}
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []models.JournalEntry
+	for rows.Next() {
+		entry, err := scanJournalEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// rowScanner *sql.Row와 *sql.Rows 공통 기능
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// journal 한 행을 읽음 (extra는 뒤에 이어지는 컬럼)
+func scanJournalEntry(row rowScanner, extra ...interface{}) (models.JournalEntry, error) {
+	var entry models.JournalEntry
+	var op, createdStr string
+	var undoneStr sql.NullString
+	dest := []interface{}{&entry.ID, &op, &entry.Summary, &entry.Entries, &createdStr, &undoneStr}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return entry, err
+	}
+	entry.Operation = models.Operation(op)
+	entry.CreatedAt, _ = parseTime(createdStr)
+	if undoneStr.Valid {
+		entry.UndoneAt, _ = parseTime(undoneStr.String)
+	}
+	return entry, nil
+}
+
+// Undo 최근 작업부터 최대 steps개를 되돌리고 되돌린 작업 반환 (되돌릴 작업이 없으면 빈 슬라이스)
+func (s *Storage) Undo(steps int) ([]models.JournalEntry, error) {
+	return s.replay(steps, `
+	SELECT id, operation, summary, entry_count, created_at, undone_at, before_image, after_image, watchlist_id
+	FROM journal WHERE undone_at IS NULL ORDER BY id DESC LIMIT 1
+	`, true)
+}
+
+// Redo 가장 최근에 되돌린 작업부터 최대 steps개를 다시 적용하고 적용한 작업 반환
+func (s *Storage) Redo(steps int) ([]models.JournalEntry, error) {
+	return s.replay(steps, `
+	SELECT id, operation, summary, entry_count, created_at, undone_at, before_image, after_image, watchlist_id
+	FROM journal WHERE undone_at IS NOT NULL ORDER BY id ASC LIMIT 1
+	`, false)
+}
+
+// next 쿼리로 고른 작업을 하나씩 적용 (undo면 작업 전 상태로, redo면 작업 후 상태로)
+// 모든 단계를 한 트랜잭션으로 처리하므로 중간에 실패하면 아무것도 바뀌지 않음
+func (s *Storage) replay(steps int, next string, undo bool) ([]models.JournalEntry, error) {
+	var replayed []models.JournalEntry
+	err := s.withTx(func(tx *sql.Tx) error {
+		for len(replayed) < steps {
+			var beforeImage, afterImage string
+			var watchlistID sql.NullInt64
+			entry, err := scanJournalEntry(tx.QueryRow(next), &beforeImage, &afterImage, &watchlistID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			var before, after []models.MediaEntry
+			if err := json.Unmarshal([]byte(beforeImage), &before); err != nil {
+				return fmt.Errorf("journal %d: %w", entry.ID, err)
+			}
+			if err := json.Unmarshal([]byte(afterImage), &after); err != nil {
+				return fmt.Errorf("journal %d: %w", entry.ID, err)
+			}
+
+			target, undone := after, interface{}(nil)
+			if undo {
+				target, undone = before, formatTime(time.Now())
+			}
+			if err := applyImage(tx, append(before, after...), target); err != nil {
+				return fmt.Errorf("journal %d: %w", entry.ID, err)
+			}
+			if watchlistID.Valid {
+				if err := replayPromotion(tx, watchlistID.Int64, after, entry.CreatedAt, undo); err != nil {
+					return fmt.Errorf("journal %d: %w", entry.ID, err)
+				}
+			}
+			if _, err := tx.Exec(`UPDATE journal SET undone_at = ? WHERE id = ?`, undone, entry.ID); err != nil {
+				return err
+			}
+			replayed = append(replayed, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return replayed, nil
+}
+
+// touched 항목들을 image의 상태로 되돌림 (image에 없는 항목은 삭제)
+func applyImage(tx *sql.Tx, touched, image []models.MediaEntry) error {
+	keep := make(map[int]models.MediaEntry, len(image))
+	for _, entry := range image {
+		keep[entry.ID] = entry
+	}
+
+	done := make(map[int]bool, len(touched))
+	for _, entry := range touched {
+		if done[entry.ID] {
+			continue
+		}
+		done[entry.ID] = true
+
+		if saved, ok := keep[entry.ID]; ok {
+			if err := restoreEntry(tx, saved); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tx.Exec(`DELETE FROM media WHERE id = ?`, entry.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 볼 예정 목록에서 옮긴 작업의 목록 항목 상태를 맞춤
+// undo면 다시 볼 예정 목록에 보이게 하고, redo면 다시 옮긴 기록(after)과 연결
+// 그 사이에 목록에서 지운 항목은 건너뜀
+func replayPromotion(tx *sql.Tx, watchlistID int64, after []models.MediaEntry, promotedAt time.Time, undo bool) error {
+	if undo || len(after) == 0 {
+		_, err := tx.Exec(`UPDATE watchlist SET media_id = NULL, promoted_at = NULL WHERE id = ?`, watchlistID)
+		return err
+	}
+	_, err := tx.Exec(`UPDATE watchlist SET media_id = ?, promoted_at = ? WHERE id = ?`,
+		after[0].ID, formatTime(promotedAt), watchlistID)
+	return err
+}
+
+// 기록된 항목을 같은 ID로 그대로 저장 (생성/수정 시각과 태그 포함)
+func restoreEntry(tx *sql.Tx, entry models.MediaEntry) error {
+	_, err := tx.Exec(`
+	INSERT INTO media (id, title, type, rating, comment, date_watched, created_at, updated_at,
+		status, season, episodes_watched, total_episodes, review, deleted_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(id) DO UPDATE SET
+		title = excluded.title, type = excluded.type, rating = excluded.rating, comment = excluded.comment,
+		date_watched = excluded.date_watched, created_at = excluded.created_at, updated_at = excluded.updated_at,
+		status = excluded.status, season = excluded.season, episodes_watched = excluded.episodes_watched,
+		total_episodes = excluded.total_episodes, review = excluded.review, deleted_at = excluded.deleted_at
+	`, entry.ID, entry.Title, string(entry.Type), entry.Rating, entry.Comment,
+		formatTime(entry.DateWatched), formatTime(entry.CreatedAt), formatTime(entry.UpdatedAt),
+		string(entry.Status), entry.Season, entry.EpisodesWatched, entry.TotalEpisodes, entry.Review,
+		nullableTime(entry.DeletedAt))
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM media_tags WHERE media_id = ?`, entry.ID); err != nil {
+		return err
+	}
+	return attachTags(tx, entry.ID, entry.Tags)
+}
+
+// zero면 NULL로 저장
+func nullableTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return formatTime(t)
+}
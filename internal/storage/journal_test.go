@@ -0,0 +1,222 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+func TestUndoRedoPromoteWatchlistItem(t *testing.T) {
+	store := newTestStorage(t)
+
+	itemID, err := store.AddWatchlistItem(models.WatchlistItem{
+		Title:   "Frieren",
+		Type:    models.Anime,
+		AddedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("AddWatchlistItem: %v", err)
+	}
+	mediaID, err := store.PromoteWatchlistItem(itemID, models.MediaEntry{
+		Title:       "Frieren",
+		Type:        models.Anime,
+		Rating:      4.5,
+		DateWatched: time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("PromoteWatchlistItem: %v", err)
+	}
+	assertPromoted(t, store, itemID, mediaID, "Frieren")
+
+	undone, err := store.Undo(1)
+	if err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if len(undone) != 1 || undone[0].Operation != models.OperationAdd {
+		t.Fatalf("Undo returned %+v, want one add", undone)
+	}
+	if _, err := store.GetByID(mediaID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetByID after undo: err = %v, want ErrNotFound", err)
+	}
+	item, err := store.GetWatchlistItem(itemID)
+	if err != nil {
+		t.Fatalf("GetWatchlistItem after undo: %v", err)
+	}
+	if item.Title != "Frieren" {
+		t.Errorf("watchlist title = %q, want Frieren", item.Title)
+	}
+	stats, err := store.watchlistStats()
+	if err != nil {
+		t.Fatalf("watchlistStats: %v", err)
+	}
+	if stats.Backlog != 1 || stats.Watched != 0 {
+		t.Errorf("watchlist stats after undo = %+v, want backlog 1, watched 0", stats)
+	}
+
+	if _, err := store.Redo(1); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	assertPromoted(t, store, itemID, mediaID, "Frieren")
+	stats, err = store.watchlistStats()
+	if err != nil {
+		t.Fatalf("watchlistStats: %v", err)
+	}
+	if stats.Watched != 1 || stats.AvgDaysToWatch != 10 {
+		t.Errorf("watchlist stats after redo = %+v, want watched 1 after 10 days", stats)
+	}
+}
+
+func TestRedoPromotionAfterWatchlistItemRemoved(t *testing.T) {
+	store := newTestStorage(t)
+
+	itemID, err := store.AddWatchlistItem(models.WatchlistItem{Title: "Dune", Type: models.Movie})
+	if err != nil {
+		t.Fatalf("AddWatchlistItem: %v", err)
+	}
+	mediaID, err := store.PromoteWatchlistItem(itemID, models.MediaEntry{Title: "Dune", Type: models.Movie})
+	if err != nil {
+		t.Fatalf("PromoteWatchlistItem: %v", err)
+	}
+	if _, err := store.Undo(1); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	if _, err := store.RemoveWatchlistItems([]int{itemID}); err != nil {
+		t.Fatalf("RemoveWatchlistItems: %v", err)
+	}
+
+	if _, err := store.Redo(1); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	if _, err := store.GetByID(mediaID); err != nil {
+		t.Errorf("GetByID after redo: %v", err)
+	}
+}
+
+func assertPromoted(t *testing.T, store *Storage, itemID, mediaID int, title string) {
+	t.Helper()
+
+	entry, err := store.GetByID(mediaID)
+	if err != nil {
+		t.Fatalf("GetByID(%d): %v", mediaID, err)
+	}
+	if entry.Title != title {
+		t.Errorf("entry title = %q, want %q", entry.Title, title)
+	}
+	if _, err := store.GetWatchlistItem(itemID); !errors.Is(err, ErrWatchlistItemNotFound) {
+		t.Errorf("GetWatchlistItem(%d): err = %v, want ErrWatchlistItemNotFound", itemID, err)
+	}
+
+	var linked int
+	if err := store.db.QueryRow(`SELECT media_id FROM watchlist WHERE id = ?`, itemID).Scan(&linked); err != nil {
+		t.Fatalf("read watchlist media_id: %v", err)
+	}
+	if linked != mediaID {
+		t.Errorf("watchlist media_id = %d, want %d", linked, mediaID)
+	}
+}
+
+func TestUndoEditKeepsLaterTagChanges(t *testing.T) {
+	store := newTestStorage(t)
+
+	id, err := store.AddEntry(models.MediaEntry{Title: "Dune", Type: models.Movie, Rating: 0.8, Tags: []string{"sci-fi"}})
+	if err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+	entry, err := store.GetByID(id)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	entry.Rating = 1
+	if err := store.UpdateEntry(id, entry); err != nil {
+		t.Fatalf("UpdateEntry: %v", err)
+	}
+	if err := store.AddTags(id, []string{"imax"}); err != nil {
+		t.Fatalf("AddTags: %v", err)
+	}
+	// 이미 있는 태그는 작업으로 기록하지 않음
+	if err := store.AddTags(id, []string{"imax"}); err != nil {
+		t.Fatalf("AddTags again: %v", err)
+	}
+
+	history, err := store.History(0)
+	if err != nil {
+		t.Fatalf("History: %v", err)
+	}
+	if len(history) != 3 || history[0].Summary != "Tagged [1] Dune" {
+		t.Fatalf("history = %+v, want add, edit and one tag change", history)
+	}
+
+	// 태그 추가부터 되돌리고, 편집을 되돌려도 원래 태그는 남음
+	assertEntryState(t, store, id, 1, "imax,sci-fi")
+	if _, err := store.Undo(1); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	assertEntryState(t, store, id, 1, "sci-fi")
+	if _, err := store.Undo(1); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	assertEntryState(t, store, id, 0.8, "sci-fi")
+
+	if _, err := store.Redo(2); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	assertEntryState(t, store, id, 1, "imax,sci-fi")
+}
+
+func TestUndoRenameAndMergeTags(t *testing.T) {
+	store := newTestStorage(t)
+
+	for _, entry := range []models.MediaEntry{
+		{Title: "Dune", Type: models.Movie, Tags: []string{"scifi"}},
+		{Title: "Arrival", Type: models.Movie, Tags: []string{"sf"}},
+	} {
+		if _, err := store.AddEntry(entry); err != nil {
+			t.Fatalf("AddEntry: %v", err)
+		}
+	}
+	if err := store.RenameTag("scifi", "sci-fi"); err != nil {
+		t.Fatalf("RenameTag: %v", err)
+	}
+	if affected, err := store.MergeTags([]string{"sf"}, "sci-fi"); err != nil || affected != 1 {
+		t.Fatalf("MergeTags = %d, %v; want 1 entry", affected, err)
+	}
+	assertTagNames(t, store, "sci-fi")
+
+	if _, err := store.Undo(2); err != nil {
+		t.Fatalf("Undo: %v", err)
+	}
+	assertTagNames(t, store, "scifi", "sf")
+
+	if _, err := store.Redo(2); err != nil {
+		t.Fatalf("Redo: %v", err)
+	}
+	assertTagNames(t, store, "sci-fi")
+}
+
+func assertEntryState(t *testing.T, store *Storage, id int, rating float64, tags string) {
+	t.Helper()
+	entry, err := store.GetByID(id)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if entry.Rating != rating || tagKey(entry.Tags) != tags {
+		t.Errorf("entry = rating %v, tags %v; want rating %v, tags %s", entry.Rating, entry.Tags, rating, tags)
+	}
+}
+
+func assertTagNames(t *testing.T, store *Storage, want ...string) {
+	t.Helper()
+	tags, err := store.ListTags()
+	if err != nil {
+		t.Fatalf("ListTags: %v", err)
+	}
+	var names []string
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+	if tagKey(names) != tagKey(want) {
+		t.Errorf("tags = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,236 @@
+package storage
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 바이너리에 포함되는 마이그레이션 파일 (형식: NNNN_name.sql)
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// Migration 번호가 매겨진 스키마 변경 단위
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// MigrationState 마이그레이션별 적용 상태
+type MigrationState struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// 포함된 마이그레이션을 버전 순으로 로드
+func loadMigrations() ([]Migration, error) {
+	files, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	var migrations []Migration
+	seen := make(map[int]string)
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || path.Ext(name) != ".sql" {
+			continue
+		}
+
+		prefix, rest, ok := strings.Cut(strings.TrimSuffix(name, ".sql"), "_")
+		if !ok {
+			return nil, fmt.Errorf("invalid migration file name: %s", name)
+		}
+		version, err := strconv.Atoi(prefix)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", name)
+		}
+		if other, dup := seen[version]; dup {
+			return nil, fmt.Errorf("duplicate migration version %d: %s, %s", version, other, name)
+		}
+		seen[version] = name
+
+		data, err := migrationFiles.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, err
+		}
+
+		migrations = append(migrations, Migration{
+			Version: version,
+			Name:    rest,
+			SQL:     string(data),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// LatestVersion 포함된 마이그레이션 중 가장 높은 버전
+func LatestVersion() (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+	if len(migrations) == 0 {
+		return 0, nil
+	}
+	return migrations[len(migrations)-1].Version, nil
+}
+
+func (s *Storage) ensureMigrationTable() error {
+	_, err := s.db.Exec(`
+	CREATE TABLE IF NOT EXISTS schema_migrations (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at DATETIME NOT NULL
+	)`)
+	return err
+}
+
+// 적용된 버전 → 적용 시각
+func (s *Storage) appliedMigrations() (map[int]time.Time, error) {
+	if err := s.ensureMigrationTable(); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.Query(`SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var appliedAtStr string
+		if err := rows.Scan(&version, &appliedAtStr); err != nil {
+			return nil, err
+		}
+		appliedAt, _ := parseTime(appliedAtStr)
+		applied[version] = appliedAt
+	}
+
+	return applied, rows.Err()
+}
+
+// SchemaVersion 현재 DB에 적용된 가장 높은 마이그레이션 버전
+func (s *Storage) SchemaVersion() (int, error) {
+	applied, err := s.appliedMigrations()
+	if err != nil {
+		return 0, err
+	}
+
+	version := 0
+	for v := range applied {
+		if v > version {
+			version = v
+		}
+	}
+	return version, nil
+}
+
+// MigrationStatus 모든 마이그레이션의 적용 여부 반환
+func (s *Storage) MigrationStatus() ([]MigrationState, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := s.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]MigrationState, 0, len(migrations))
+	for _, m := range migrations {
+		appliedAt, ok := applied[m.Version]
+		states = append(states, MigrationState{
+			Migration: m,
+			Applied:   ok,
+			AppliedAt: appliedAt,
+		})
+	}
+	return states, nil
+}
+
+// Migrate 아직 적용되지 않은 모든 마이그레이션 적용
+func (s *Storage) Migrate() error {
+	latest, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+	_, err = s.MigrateTo(latest)
+	return err
+}
+
+// MigrateTo 지정한 버전까지 마이그레이션 적용 (다운그레이드는 지원하지 않음)
+// 적용된 마이그레이션 목록을 반환
+func (s *Storage) MigrateTo(target int) ([]Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	latest := 0
+	if len(migrations) > 0 {
+		latest = migrations[len(migrations)-1].Version
+	}
+	if target < 0 || target > latest {
+		return nil, fmt.Errorf("unknown schema version %d (latest is %d)", target, latest)
+	}
+
+	current, err := s.SchemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	if target < current {
+		return nil, fmt.Errorf("cannot downgrade schema from version %d to %d", current, target)
+	}
+
+	var done []Migration
+	for _, m := range migrations {
+		if m.Version <= current || m.Version > target {
+			continue
+		}
+		if err := s.applyMigration(m); err != nil {
+			return done, fmt.Errorf("migration %04d_%s failed: %w", m.Version, m.Name, err)
+		}
+		done = append(done, m)
+	}
+
+	return done, nil
+}
+
+// 마이그레이션 하나를 트랜잭션 안에서 적용하고 기록
+func (s *Storage) applyMigration(m Migration) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(m.SQL); err != nil {
+		return err
+	}
+
+	now := time.Now().Format("2006-01-02 15:04:05")
+	if _, err := tx.Exec(
+		`INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)`,
+		m.Version, m.Name, now,
+	); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
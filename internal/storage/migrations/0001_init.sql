@@ -0,0 +1,14 @@
+-- 초기 스키마: 기존 initDB와 동일 (기존 DB에서도 안전하게 실행되도록 IF NOT EXISTS 사용)
+CREATE TABLE IF NOT EXISTS media (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	title TEXT NOT NULL,
+	type TEXT CHECK(type IN ('movie', 'drama')) NOT NULL,
+	rating REAL CHECK(rating >= 0 AND rating <= 5),
+	comment TEXT,
+	date_watched DATETIME NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_type ON media(type);
+CREATE INDEX IF NOT EXISTS idx_media_rating ON media(rating);
+CREATE INDEX IF NOT EXISTS idx_media_date_watched ON media(date_watched);
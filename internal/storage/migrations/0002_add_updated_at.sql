@@ -0,0 +1,4 @@
+-- 수정 시각을 생성 시각과 분리해서 기록
+ALTER TABLE media ADD COLUMN updated_at DATETIME;
+
+UPDATE media SET updated_at = created_at WHERE updated_at IS NULL;
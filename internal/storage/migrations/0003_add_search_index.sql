@@ -0,0 +1,25 @@
+-- 제목/한줄평 전문 검색 인덱스 (media 테이블을 외부 콘텐츠로 사용)
+CREATE VIRTUAL TABLE IF NOT EXISTS media_fts USING fts5(
+	title,
+	comment,
+	content='media',
+	content_rowid='id',
+	tokenize='unicode61 remove_diacritics 2'
+);
+
+-- media 변경 시 인덱스 동기화
+CREATE TRIGGER IF NOT EXISTS media_fts_ai AFTER INSERT ON media BEGIN
+	INSERT INTO media_fts(rowid, title, comment) VALUES (new.id, new.title, new.comment);
+END;
+
+CREATE TRIGGER IF NOT EXISTS media_fts_ad AFTER DELETE ON media BEGIN
+	INSERT INTO media_fts(media_fts, rowid, title, comment) VALUES ('delete', old.id, old.title, old.comment);
+END;
+
+CREATE TRIGGER IF NOT EXISTS media_fts_au AFTER UPDATE OF title, comment ON media BEGIN
+	INSERT INTO media_fts(media_fts, rowid, title, comment) VALUES ('delete', old.id, old.title, old.comment);
+	INSERT INTO media_fts(rowid, title, comment) VALUES (new.id, new.title, new.comment);
+END;
+
+-- 기존 데이터 색인
+INSERT INTO media_fts(media_fts) VALUES ('rebuild');
@@ -0,0 +1,26 @@
+-- 장르/태그 (대소문자 무시하고 이름이 같으면 같은 태그)
+CREATE TABLE IF NOT EXISTS tags (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL UNIQUE COLLATE NOCASE
+);
+
+-- 항목-태그 다대다 연결
+CREATE TABLE IF NOT EXISTS media_tags (
+	media_id INTEGER NOT NULL REFERENCES media(id),
+	tag_id INTEGER NOT NULL REFERENCES tags(id),
+	PRIMARY KEY (media_id, tag_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_media_tags_tag_id ON media_tags(tag_id);
+
+-- 외래 키 제약을 켜지 않으므로 트리거로 정리: 항목이 삭제되면 연결도 삭제
+CREATE TRIGGER IF NOT EXISTS media_tags_media_ad AFTER DELETE ON media BEGIN
+	DELETE FROM media_tags WHERE media_id = old.id;
+END;
+
+-- 더 이상 쓰이지 않는 태그 삭제
+CREATE TRIGGER IF NOT EXISTS media_tags_ad AFTER DELETE ON media_tags BEGIN
+	DELETE FROM tags
+	WHERE id = old.tag_id
+		AND NOT EXISTS (SELECT 1 FROM media_tags WHERE tag_id = old.tag_id);
+END;
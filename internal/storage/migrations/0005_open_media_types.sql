@@ -0,0 +1,46 @@
+-- type CHECK 제약 제거 (타입은 models 레지스트리와 config.yaml에서 관리)
+-- SQLite는 제약만 지울 수 없으므로 테이블을 다시 만들고 ID를 그대로 옮김
+CREATE TABLE media_new (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	title TEXT NOT NULL,
+	type TEXT NOT NULL CHECK(type <> ''),
+	rating REAL CHECK(rating >= 0 AND rating <= 5),
+	comment TEXT,
+	date_watched DATETIME NOT NULL,
+	created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+	updated_at DATETIME
+);
+
+INSERT INTO media_new (id, title, type, rating, comment, date_watched, created_at, updated_at)
+SELECT id, title, type, rating, comment, date_watched, created_at, updated_at FROM media;
+
+-- 삭제된 ID가 다시 쓰이지 않도록 AUTOINCREMENT 순번 유지
+UPDATE sqlite_sequence
+SET seq = MAX(seq, COALESCE((SELECT seq FROM sqlite_sequence WHERE name = 'media'), 0))
+WHERE name = 'media_new';
+
+-- 외래 키 제약을 켜지 않으므로 DROP TABLE은 트리거를 실행하지 않음 (태그 연결 유지)
+DROP TABLE media;
+ALTER TABLE media_new RENAME TO media;
+
+CREATE INDEX idx_media_type ON media(type);
+CREATE INDEX idx_media_rating ON media(rating);
+CREATE INDEX idx_media_date_watched ON media(date_watched);
+
+-- media에 걸려 있던 트리거 다시 생성 (0003, 0004와 동일)
+CREATE TRIGGER media_fts_ai AFTER INSERT ON media BEGIN
+	INSERT INTO media_fts(rowid, title, comment) VALUES (new.id, new.title, new.comment);
+END;
+
+CREATE TRIGGER media_fts_ad AFTER DELETE ON media BEGIN
+	INSERT INTO media_fts(media_fts, rowid, title, comment) VALUES ('delete', old.id, old.title, old.comment);
+END;
+
+CREATE TRIGGER media_fts_au AFTER UPDATE OF title, comment ON media BEGIN
+	INSERT INTO media_fts(media_fts, rowid, title, comment) VALUES ('delete', old.id, old.title, old.comment);
+	INSERT INTO media_fts(rowid, title, comment) VALUES (new.id, new.title, new.comment);
+END;
+
+CREATE TRIGGER media_tags_media_ad AFTER DELETE ON media BEGIN
+	DELETE FROM media_tags WHERE media_id = old.id;
+END;
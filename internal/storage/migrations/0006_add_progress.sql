@@ -0,0 +1,8 @@
+-- 시청 상태와 회차 진행 상황 (기존 항목은 다 본 것으로 간주)
+ALTER TABLE media ADD COLUMN status TEXT NOT NULL DEFAULT 'completed'
+	CHECK(status IN ('watching', 'completed', 'dropped', 'on-hold'));
+ALTER TABLE media ADD COLUMN season INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE media ADD COLUMN episodes_watched INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE media ADD COLUMN total_episodes INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_media_status ON media(status);
@@ -0,0 +1,19 @@
+-- 볼 예정인 작품 목록 (promoted_at이 있으면 이미 봐서 기록으로 옮긴 항목)
+CREATE TABLE IF NOT EXISTS watchlist (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	title TEXT NOT NULL,
+	type TEXT NOT NULL CHECK(type <> ''),
+	priority INTEGER NOT NULL DEFAULT 2 CHECK(priority BETWEEN 1 AND 3),
+	recommended_by TEXT,
+	note TEXT,
+	added_at DATETIME NOT NULL,
+	media_id INTEGER REFERENCES media(id),
+	promoted_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlist_promoted_at ON watchlist(promoted_at);
+
+-- 기록이 삭제돼도 옮긴 이력(promoted_at)은 통계용으로 남김
+CREATE TRIGGER IF NOT EXISTS watchlist_media_ad AFTER DELETE ON media BEGIN
+	UPDATE watchlist SET media_id = NULL WHERE media_id = old.id;
+END;
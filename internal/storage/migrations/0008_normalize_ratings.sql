@@ -0,0 +1,4 @@
+-- 평점을 척도와 무관한 정규화 값으로 저장 (0이면 평가 안 함, 그 외 최고 평점 대비 비율 0~1)
+-- 지금까지는 항상 0~5 범위로 저장됐으므로 5로 나눔 (기존 CHECK 제약 0~5는 그대로 만족)
+UPDATE media SET rating = rating / 5.0 WHERE rating > 0;
+UPDATE media SET rating = 0 WHERE rating IS NULL;
@@ -0,0 +1,2 @@
+-- 한줄평과 별도로 저장하는 긴 감상문 (Markdown)
+ALTER TABLE media ADD COLUMN review TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,15 @@
+-- 작업 기록 (undo/redo용): add/edit/delete/import 전후의 항목 상태를 JSON 배열로 저장
+-- before_image에 없는 항목은 작업으로 생긴 것, after_image에 없는 항목은 작업으로 지워진 것
+-- undone_at이 있으면 되돌린 작업 (redo 대상)
+CREATE TABLE IF NOT EXISTS journal (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	operation TEXT NOT NULL CHECK(operation IN ('add', 'edit', 'delete', 'import')),
+	summary TEXT NOT NULL,
+	entry_count INTEGER NOT NULL,
+	before_image TEXT NOT NULL,
+	after_image TEXT NOT NULL,
+	created_at DATETIME NOT NULL,
+	undone_at DATETIME
+);
+
+CREATE INDEX IF NOT EXISTS idx_journal_undone_at ON journal(undone_at);
@@ -0,0 +1,4 @@
+-- 휴지통: 삭제한 항목은 deleted_at만 기록하고 남겨 둠 (trash purge로 실제 삭제)
+ALTER TABLE media ADD COLUMN deleted_at DATETIME;
+
+CREATE INDEX IF NOT EXISTS idx_media_deleted_at ON media(deleted_at);
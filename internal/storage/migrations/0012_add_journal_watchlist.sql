@@ -0,0 +1,2 @@
+-- 볼 예정 목록에서 옮긴 작업이면 옮긴 목록 항목 (undo하면 목록으로 되돌리고 redo하면 다시 옮김)
+ALTER TABLE journal ADD COLUMN watchlist_id INTEGER;
@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+func TestProgressWritersRejectInvalidProgress(t *testing.T) {
+	store := newTestStorage(t)
+
+	frieren := models.MediaEntry{Title: "Frieren", Type: models.Anime, Status: models.StatusWatching,
+		Season: 1, EpisodesWatched: 12, TotalEpisodes: 28}
+	id, err := store.AddEntry(frieren)
+	if err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+
+	past := frieren
+	past.EpisodesWatched = 30
+	negative := frieren
+	negative.Season = -1
+
+	writers := []struct {
+		name  string
+		write func(models.MediaEntry) error
+	}{
+		{"AddEntry", func(e models.MediaEntry) error { _, err := store.AddEntry(e); return err }},
+		{"ImportEntries", func(e models.MediaEntry) error { _, err := store.ImportEntries([]models.MediaEntry{e}); return err }},
+		{"ReplaceEntry", func(e models.MediaEntry) error { return store.ReplaceEntry(id, e) }},
+		{"UpdateProgress", func(e models.MediaEntry) error { return store.UpdateProgress(id, e) }},
+	}
+	for _, w := range writers {
+		for _, entry := range []models.MediaEntry{past, negative} {
+			if err := w.write(entry); !errors.Is(err, ErrInvalidProgress) {
+				t.Errorf("%s(season %d, %d/%d) error = %v, want ErrInvalidProgress",
+					w.name, entry.Season, entry.EpisodesWatched, entry.TotalEpisodes, err)
+			}
+		}
+	}
+
+	// 전체 회차 수를 모르면 본 회차만 기록할 수 있음
+	unknownTotal := frieren
+	unknownTotal.EpisodesWatched, unknownTotal.TotalEpisodes = 40, 0
+	if err := store.UpdateProgress(id, unknownTotal); err != nil {
+		t.Fatalf("UpdateProgress without total: %v", err)
+	}
+
+	got, err := store.GetByID(id)
+	if err != nil {
+		t.Fatalf("GetByID: %v", err)
+	}
+	if got.EpisodesWatched != 40 || got.TotalEpisodes != 0 {
+		t.Errorf("progress = %d/%d, want 40/0", got.EpisodesWatched, got.TotalEpisodes)
+	}
+	if count, err := store.CountEntries(Query{}); err != nil || count != 1 {
+		t.Errorf("CountEntries = %d, %v; rejected writes must not add entries", count, err)
+	}
+}
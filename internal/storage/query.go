@@ -0,0 +1,281 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// SortField 정렬 기준
+type SortField string
+
+const (
+	SortDate    SortField = "date"    // 시청일 (기본: 최신순)
+	SortTitle   SortField = "title"   // 제목 (기본: 가나다/ABC순)
+	SortRating  SortField = "rating"  // 평점 (기본: 높은순)
+	SortID      SortField = "id"      // ID (기본: 최신순)
+	SortUpdated SortField = "updated" // 마지막 수정 시각 (기본: 최신순)
+)
+
+// ParseSortField 문자열을 정렬 기준으로 변환
+func ParseSortField(s string) (SortField, error) {
+	switch f := SortField(strings.ToLower(strings.TrimSpace(s))); f {
+	case SortDate, SortTitle, SortRating, SortID, SortUpdated:
+		return f, nil
+	case "":
+		return SortDate, nil
+	default:
+		return "", fmt.Errorf("unknown sort field %q (expected title, rating, date or updated)", s)
+	}
+}
+
+// 정렬 기준별 ORDER BY 절 (동률일 때는 ID로 고정된 순서 보장)
+var sortClauses = map[SortField][2]string{
+	SortDate:    {"date_watched DESC, id DESC", "date_watched ASC, id ASC"},
+	SortTitle:   {"title COLLATE NOCASE ASC, date_watched DESC", "title COLLATE NOCASE DESC, date_watched ASC"},
+	SortRating:  {"rating DESC, date_watched DESC", "rating ASC, date_watched ASC"},
+	SortID:      {"id DESC", "id ASC"},
+	SortUpdated: {"updated_at DESC, id DESC", "updated_at ASC, id ASC"},
+}
+
+// Query 항목 조회 조건 (0 값인 필드는 조건에서 제외)
+type Query struct {
+	Type      models.MediaType // 미디어 타입
+	Year      int              // 시청 연도
+	From      time.Time        // 이 날짜 이후 시청 (포함)
+	To        time.Time        // 이 날짜까지 시청 (그날 포함)
+	MinRating *float64         // 최소 평점 (포함)
+	MaxRating *float64         // 최대 평점 (포함)
+	Tags      []string         // 모든 태그를 가진 항목만 (대소문자 무시)
+	Status    models.Status    // 시청 상태
+
+	Sort    SortField // 정렬 기준 (기본: 시청일)
+	Reverse bool      // 기본 정렬 방향 반전
+	Limit   int       // 최대 개수 (0이면 제한 없음)
+	Offset  int       // 건너뛸 개수
+}
+
+// media 테이블에서 읽는 컬럼 (뒤에 태그 목록 컬럼이 이어짐)
+var entryFields = []string{
+	"id", "title", "type", "rating", "comment", "date_watched", "created_at", "updated_at",
+	"status", "season", "episodes_watched", "total_episodes", "review", "deleted_at",
+}
+
+var entryColumns = entryColumnsFor("media")
+
+// 태그 이름 구분자 (태그 이름에는 쉼표가 들어갈 수 없음)
+const tagSeparator = ","
+
+// DB에 저장되는 시간 형식
+const timeFormat = "2006-01-02 15:04:05"
+
+// 조건을 하나씩 AND로 조합하는 WHERE 절 빌더
+type whereBuilder struct {
+	clauses []string
+	args    []interface{}
+}
+
+func (w *whereBuilder) add(clause string, args ...interface{}) {
+	w.clauses = append(w.clauses, clause)
+	w.args = append(w.args, args...)
+}
+
+func (w *whereBuilder) String() string {
+	if len(w.clauses) == 0 {
+		return ""
+	}
+	return "WHERE " + strings.Join(w.clauses, " AND ")
+}
+
+// WHERE 절과 인자 생성
+func (q Query) where() (string, []interface{}) {
+	var w whereBuilder
+	w.add("deleted_at IS NULL")
+
+	if q.Type != "" {
+		w.add("type = ?", string(q.Type))
+	}
+	if q.Year != 0 {
+		w.add("strftime('%Y', date_watched) = ?", fmt.Sprintf("%04d", q.Year))
+	}
+	if !q.From.IsZero() {
+		w.add("date_watched >= ?", formatTime(startOfDay(q.From)))
+	}
+	if !q.To.IsZero() {
+		w.add("date_watched < ?", formatTime(startOfDay(q.To).AddDate(0, 0, 1)))
+	}
+	if q.MinRating != nil {
+		w.add("rating >= ?", *q.MinRating)
+	}
+	if q.MaxRating != nil {
+		w.add("rating <= ?", *q.MaxRating)
+	}
+	if q.Status != "" {
+		w.add("status = ?", string(q.Status))
+	}
+	for _, tag := range q.Tags {
+		w.add(tagFilter("id"), tag)
+	}
+
+	return w.String(), w.args
+}
+
+func (q Query) orderBy() string {
+	field := q.Sort
+	if field == "" {
+		field = SortDate
+	}
+	clauses, ok := sortClauses[field]
+	if !ok {
+		clauses = sortClauses[SortDate]
+	}
+	if q.Reverse {
+		return clauses[1]
+	}
+	return clauses[0]
+}
+
+func (q Query) build() (string, []interface{}) {
+	where, args := q.where()
+	query := fmt.Sprintf(`SELECT %s FROM media %s ORDER BY %s`, entryColumns, where, q.orderBy())
+
+	if q.Limit > 0 || q.Offset > 0 {
+		limit := q.Limit
+		if limit <= 0 {
+			limit = -1 // SQLite: 제한 없음
+		}
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, limit, q.Offset)
+	}
+
+	return query, args
+}
+
+// CountEntries 조건에 맞는 항목 수 (Limit/Offset 무시)
+func (s *Storage) CountEntries(q Query) (int, error) {
+	where, args := q.where()
+
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM media `+where, args...).Scan(&count)
+	return count, err
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// FindEntries 조건에 맞는 항목 전체 반환
+func (s *Storage) FindEntries(q Query) ([]models.MediaEntry, error) {
+	query, args := q.build()
+	return s.queryEntries(query, args...)
+}
+
+// StreamEntries 조건에 맞는 항목을 한 건씩 fn에 전달 (전체를 메모리에 올리지 않음)
+func (s *Storage) StreamEntries(q Query, fn func(models.MediaEntry) error) error {
+	query, args := q.build()
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// 테이블 별칭을 붙인 entryColumns (JOIN 쿼리용)
+func entryColumnsFor(alias string) string {
+	columns := make([]string, len(entryFields), len(entryFields)+1)
+	for i, column := range entryFields {
+		columns[i] = alias + "." + column
+	}
+	columns = append(columns, fmt.Sprintf(`(SELECT group_concat(t.name, '%s')
+		FROM media_tags mt JOIN tags t ON t.id = mt.tag_id
+		WHERE mt.media_id = %s.id)`, tagSeparator, alias))
+	return strings.Join(columns, ", ")
+}
+
+// idColumn 항목이 태그(인자 하나)를 가지고 있는지 검사하는 조건
+func tagFilter(idColumn string) string {
+	return idColumn + ` IN (SELECT mt.media_id FROM media_tags mt JOIN tags t ON t.id = mt.tag_id WHERE t.name = ?)`
+}
+
+// entryColumns 순서대로 한 행을 읽어 MediaEntry로 변환 (extra는 뒤에 이어지는 컬럼)
+func scanEntry(rows *sql.Rows, extra ...interface{}) (models.MediaEntry, error) {
+	var entry models.MediaEntry
+	var typeStr, watchedStr string
+	var comment, createdStr, updatedStr, deletedStr, tags sql.NullString
+
+	dest := []interface{}{
+		&entry.ID, &entry.Title, &typeStr, &entry.Rating, &comment, &watchedStr, &createdStr, &updatedStr,
+		&entry.Status, &entry.Season, &entry.EpisodesWatched, &entry.TotalEpisodes, &entry.Review, &deletedStr, &tags,
+	}
+	if err := rows.Scan(append(dest, extra...)...); err != nil {
+		return entry, err
+	}
+
+	entry.Type = models.MediaType(typeStr)
+	entry.Comment = comment.String
+	if tags.String != "" {
+		entry.Tags = strings.Split(tags.String, tagSeparator)
+		sort.Slice(entry.Tags, func(i, j int) bool {
+			return strings.ToLower(entry.Tags[i]) < strings.ToLower(entry.Tags[j])
+		})
+	}
+
+	var err error
+	if entry.DateWatched, err = parseTime(watchedStr); err != nil {
+		return entry, err
+	}
+	// created_at/updated_at은 파싱 실패해도 계속 진행
+	entry.CreatedAt, _ = parseTime(createdStr.String)
+	entry.UpdatedAt, _ = parseTime(updatedStr.String)
+	if deletedStr.Valid {
+		entry.DeletedAt, _ = parseLocalTime(deletedStr.String)
+	}
+
+	return entry, nil
+}
+
+// queryer *sql.DB와 *sql.Tx 공통 조회 기능
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// 쿼리 결과 전체를 MediaEntry 슬라이스로 반환
+func (s *Storage) queryEntries(query string, args ...interface{}) ([]models.MediaEntry, error) {
+	return queryEntriesIn(s.db, query, args...)
+}
+
+// queryEntries와 같지만 트랜잭션 안에서도 사용
+func queryEntriesIn(q queryer, query string, args ...interface{}) ([]models.MediaEntry, error) {
+	rows, err := q.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []models.MediaEntry
+	for rows.Next() {
+		entry, err := scanEntry(rows)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
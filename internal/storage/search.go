@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// SearchOptions 전문 검색 옵션
+type SearchOptions struct {
+	Type           models.MediaType // 비어 있으면 전체 타입
+	Tags           []string         // 모든 태그를 가진 항목만
+	Limit          int              // 최대 결과 수 (0이면 제한 없음)
+	CaseSensitive  bool             // 대소문자 구분 (FTS5는 구분하지 않으므로 결과를 후처리)
+	HighlightStart string           // 일치 부분 앞에 붙일 표시
+	HighlightEnd   string           // 일치 부분 뒤에 붙일 표시
+}
+
+// SearchResult 검색 결과 한 건
+type SearchResult struct {
+	Entry          models.MediaEntry
+	Rank           float64 // bm25 점수 (작을수록 관련도 높음)
+	TitleMatch     string  // 일치 부분이 표시된 제목
+	CommentSnippet string  // 일치 부분 주변의 한줄평 발췌
+}
+
+// 제목 일치에 가중치를 더 줌
+const searchRankExpr = `bm25(media_fts, 10.0, 1.0)`
+
+// Search 제목과 한줄평에서 query의 모든 단어(접두어 일치)를 포함하는 항목을 관련도 순으로 반환
+func (s *Storage) Search(query string, opts SearchOptions) ([]SearchResult, error) {
+	terms := strings.Fields(query)
+	if len(terms) == 0 {
+		return nil, nil
+	}
+
+	// 예전 DB에서 옮겨 온 항목은 comment가 NULL일 수 있으므로 보조 컬럼은 빈 문자열로
+	sqlQuery := `
+	SELECT ` + entryColumnsFor("m") + `, ` + searchRankExpr + ` AS rank,
+		COALESCE(highlight(media_fts, 0, ?, ?), ''),
+		COALESCE(snippet(media_fts, 1, ?, ?, '…', 12), '')
+	FROM media_fts
+	JOIN media m ON m.id = media_fts.rowid
+	WHERE media_fts MATCH ? AND m.deleted_at IS NULL`
+	args := []interface{}{
+		opts.HighlightStart, opts.HighlightEnd,
+		opts.HighlightStart, opts.HighlightEnd,
+		matchExpression(terms),
+	}
+
+	if opts.Type != "" {
+		sqlQuery += ` AND m.type = ?`
+		args = append(args, string(opts.Type))
+	}
+	for _, tag := range opts.Tags {
+		sqlQuery += ` AND ` + tagFilter("m.id")
+		args = append(args, tag)
+	}
+	sqlQuery += ` ORDER BY rank, m.date_watched DESC`
+
+	// 대소문자 구분 시에는 후처리로 걸러지므로 전체를 가져온 뒤 자름
+	if opts.Limit > 0 && !opts.CaseSensitive {
+		sqlQuery += ` LIMIT ?`
+		args = append(args, opts.Limit)
+	}
+
+	rows, err := s.db.Query(sqlQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []SearchResult
+	for rows.Next() {
+		var result SearchResult
+		entry, err := scanEntry(rows, &result.Rank, &result.TitleMatch, &result.CommentSnippet)
+		if err != nil {
+			return nil, err
+		}
+		result.Entry = entry
+
+		if opts.CaseSensitive && !containsAllTerms(entry, terms) {
+			continue
+		}
+
+		results = append(results, result)
+		if opts.Limit > 0 && len(results) >= opts.Limit {
+			break
+		}
+	}
+
+	return results, rows.Err()
+}
+
+// 사용자 입력을 FTS5 문법으로 안전하게 변환: 각 단어를 따옴표로 감싸고 접두어 일치
+func matchExpression(terms []string) string {
+	quoted := make([]string, len(terms))
+	for i, term := range terms {
+		quoted[i] = `"` + strings.ReplaceAll(term, `"`, `""`) + `"*`
+	}
+	return strings.Join(quoted, " ")
+}
+
+// 모든 단어가 제목이나 한줄평에 대소문자 그대로 포함되는지
+func containsAllTerms(entry models.MediaEntry, terms []string) bool {
+	for _, term := range terms {
+		if !strings.Contains(entry.Title, term) && !strings.Contains(entry.Comment, term) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+func TestSearchEntryWithNullComment(t *testing.T) {
+	store := newTestStorage(t)
+
+	// 초기 스키마 시절처럼 comment 없이 저장된 항목
+	if _, err := store.db.Exec(`
+	INSERT INTO media (title, type, rating, date_watched, created_at, updated_at)
+	VALUES ('Inception', 'movie', 0.9, '2010-07-20 00:00:00', '2010-07-20 00:00:00', '2010-07-20 00:00:00')
+	`); err != nil {
+		t.Fatalf("insert: %v", err)
+	}
+	if _, err := store.AddEntry(models.MediaEntry{Title: "Interstellar", Type: models.Movie, Comment: "Inception's sibling"}); err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+
+	results, err := store.Search("Inception", SearchOptions{HighlightStart: "[", HighlightEnd: "]"})
+	if err != nil {
+		t.Fatalf("Search: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	first := results[0]
+	if first.Entry.Title != "Inception" || first.TitleMatch != "[Inception]" || first.CommentSnippet != "" {
+		t.Errorf("first result = %+v, want Inception with an empty snippet", first)
+	}
+	if results[1].CommentSnippet != "[Inception]'s sibling" {
+		t.Errorf("comment snippet = %q", results[1].CommentSnippet)
+	}
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -11,6 +12,12 @@ import (
 	_ "modernc.org/sqlite"
 )
 
+// ErrNotFound 조건에 맞는 항목이 없음
+var ErrNotFound = errors.New("entry not found")
+
+// ErrInvalidProgress 회차 진행 상황이 맞지 않음 (음수이거나 본 회차가 전체 회차 수를 넘음)
+var ErrInvalidProgress = errors.New("invalid episode progress")
+
 type Storage struct {
 	db *sql.DB
 }
@@ -31,7 +38,23 @@ func ensureDataDir() error {
 	return os.MkdirAll(filepath.Dir(dbPath), 0755)
 }
 
+// NewStorage DB를 열고 최신 스키마까지 마이그레이션 적용
 func NewStorage() (*Storage, error) {
+	storage, err := OpenStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.Migrate(); err != nil {
+		storage.Close()
+		return nil, err
+	}
+
+	return storage, nil
+}
+
+// OpenStorage 마이그레이션 없이 DB만 연다 (db migrate 명령용)
+func OpenStorage() (*Storage, error) {
 	if err := ensureDataDir(); err != nil {
 		return nil, err
 	}
@@ -46,59 +69,117 @@ func NewStorage() (*Storage, error) {
 		return nil, err
 	}
 
-	storage := &Storage{db: db}
-	if err := storage.initDB(); err != nil {
-		db.Close()
-		return nil, err
+	return &Storage{db: db}, nil
+}
+
+func (s *Storage) Close() error {
+	if s.db != nil {
+		return s.db.Close()
 	}
+	return nil
+}
 
-	return storage, nil
+// AddEntry 항목을 추가하고 새 항목의 ID 반환 (시청일이 비어 있으면 현재 시각)
+func (s *Storage) AddEntry(entry models.MediaEntry) (int, error) {
+	now := time.Now()
+	if entry.DateWatched.IsZero() {
+		entry.DateWatched = now
+	}
+	entry.CreatedAt = now
+
+	var id int
+	err := s.withTx(func(tx *sql.Tx) error {
+		var err error
+		if id, err = insertEntry(tx, entry, now); err != nil {
+			return err
+		}
+		return recordOperation(tx, models.OperationAdd,
+			fmt.Sprintf("Added [%d] %s", id, entry.Title), nil, []int{id})
+	})
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
 }
 
-func (s *Storage) initDB() error {
-	schema := `
-	CREATE TABLE IF NOT EXISTS media (
-		id INTEGER PRIMARY KEY AUTOINCREMENT,
-		title TEXT NOT NULL,
-		type TEXT CHECK(type IN ('movie', 'drama')) NOT NULL,
-		rating REAL CHECK(rating >= 0 AND rating <= 5),
-		comment TEXT,
-		date_watched DATETIME NOT NULL,
-		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_media_type ON media(type);
-	CREATE INDEX IF NOT EXISTS idx_media_rating ON media(rating);
-	CREATE INDEX IF NOT EXISTS idx_media_date_watched ON media(date_watched);
-	`
+// 항목과 태그를 한 번에 추가하고 새 항목의 ID 반환
+func insertEntry(tx *sql.Tx, entry models.MediaEntry, now time.Time) (int, error) {
+	if entry.Status == "" {
+		entry.Status = models.StatusCompleted
+	}
+	if err := validateProgress(entry); err != nil {
+		return 0, err
+	}
 
-	_, err := s.db.Exec(schema)
-	return err
+	result, err := tx.Exec(`
+	INSERT INTO media (title, type, rating, comment, date_watched, created_at, updated_at,
+		status, season, episodes_watched, total_episodes, review)
+	VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, entry.Title, string(entry.Type), entry.Rating, entry.Comment,
+		formatTime(entry.DateWatched), formatTime(entry.CreatedAt), formatTime(now),
+		string(entry.Status), entry.Season, entry.EpisodesWatched, entry.TotalEpisodes, entry.Review)
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+	return int(id), attachTags(tx, int(id), entry.Tags)
 }
 
-func (s *Storage) Close() error {
-	if s.db != nil {
-		return s.db.Close()
+// ImportEntries 여러 항목을 하나의 트랜잭션으로 추가 (시청일/생성일 유지)
+func (s *Storage) ImportEntries(entries []models.MediaEntry) (int, error) {
+	now := time.Now()
+	err := s.withTx(func(tx *sql.Tx) error {
+		ids := make([]int, 0, len(entries))
+		for i, entry := range entries {
+			if entry.DateWatched.IsZero() {
+				entry.DateWatched = now
+			}
+			if entry.CreatedAt.IsZero() {
+				entry.CreatedAt = now
+			}
+
+			id, err := insertEntry(tx, entry, now)
+			if err != nil {
+				return fmt.Errorf("entry %d (%s): %w", i+1, entry.Title, err)
+			}
+			ids = append(ids, id)
+		}
+		return recordOperation(tx, models.OperationImport,
+			fmt.Sprintf("Imported %d entries", len(ids)), nil, ids)
+	})
+	if err != nil {
+		return 0, err
 	}
-	return nil
+	return len(entries), nil
 }
 
-func (s *Storage) AddEntry(entry models.MediaEntry) error {
-	query := `
-	INSERT INTO media (title, type, rating, comment, date_watched)
-	VALUES (?, ?, ?, ?, ?)
-	`
+// fn을 하나의 트랜잭션으로 실행 (에러가 나면 롤백)
+func (s *Storage) withTx(fn func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
 
-	// SQLite 호환 포맷으로 시간 저장
-	now := time.Now().Format("2006-01-02 15:04:05")
-	_, err := s.db.Exec(query, entry.Title, string(entry.Type), entry.Rating, entry.Comment, now)
-	return err
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// SQLite 호환 포맷으로 시간 저장
+func formatTime(t time.Time) string {
+	return t.Format(timeFormat)
 }
 
 // parseTime tries multiple time formats
 func parseTime(timeStr string) (time.Time, error) {
 	formats := []string{
-		"2006-01-02 15:04:05",
+		timeFormat,
 		"2006-01-02T15:04:05Z",
 		"2006-01-02T15:04:05.000Z",
 		"2006-01-02T15:04:05-07:00",
@@ -114,311 +195,232 @@ func parseTime(timeStr string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("unable to parse time: %s", timeStr)
 }
 
-func (s *Storage) GetAllEntries() ([]models.MediaEntry, error) {
-	query := `
-	SELECT id, title, type, rating, comment, date_watched, created_at
-	FROM media
-	ORDER BY id DESC
-	`
-
-	rows, err := s.db.Query(query)
+// time.Now()로만 기록하는 시각(deleted_at)은 저장된 벽시계 시각을 로컬 시각으로 읽음
+// (드라이버가 DATETIME을 UTC로 돌려주므로 parseTime 결과의 시간대를 바꿈)
+func parseLocalTime(timeStr string) (time.Time, error) {
+	t, err := parseTime(timeStr)
 	if err != nil {
-		return nil, err
+		return t, err
 	}
-	defer rows.Close()
-
-	var entries []models.MediaEntry
-	for rows.Next() {
-		var entry models.MediaEntry
-		var typeStr string
-		var dateWatchedStr, createdAtStr string
-
-		err := rows.Scan(
-			&entry.ID,
-			&entry.Title,
-			&typeStr,
-			&entry.Rating,
-			&entry.Comment,
-			&dateWatchedStr,
-			&createdAtStr,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		entry.Type = models.MediaType(typeStr)
-
-		// 시간 파싱
-		if entry.DateWatched, err = parseTime(dateWatchedStr); err != nil {
-			return nil, err
-		}
-		if entry.CreatedAt, err = parseTime(createdAtStr); err != nil {
-			// created_at이 파싱 실패해도 계속 진행
-			entry.CreatedAt = time.Time{}
-		}
-
-		entries = append(entries, entry)
-	}
-
-	return entries, rows.Err()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.Local), nil
 }
 
-func (s *Storage) GetEntriesByYear(year int) ([]models.MediaEntry, error) {
-	query := `
-	SELECT id, title, type, rating, comment, date_watched, created_at
+func (s *Storage) FindAllByTitleAndType(title string, mediaType models.MediaType) ([]models.MediaEntry, error) {
+	query := `SELECT ` + entryColumns + `
 	FROM media
-	WHERE strftime('%Y', date_watched) = ?
+	WHERE title = ? AND type = ? AND deleted_at IS NULL
 	ORDER BY id DESC
 	`
 
-	rows, err := s.db.Query(query, fmt.Sprintf("%d", year)) // 정수를 문자열로 변환
+	entries, err := s.queryEntries(query, title, string(mediaType))
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var entries []models.MediaEntry
-	for rows.Next() {
-		var entry models.MediaEntry
-		var typeStr string
-		var dateWatchedStr, createdAtStr string
-
-		err := rows.Scan(
-			&entry.ID,
-			&entry.Title,
-			&typeStr,
-			&entry.Rating,
-			&entry.Comment,
-			&dateWatchedStr,
-			&createdAtStr,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		entry.Type = models.MediaType(typeStr)
 
-		// 시간 파싱
-		if entry.DateWatched, err = parseTime(dateWatchedStr); err != nil {
-			return nil, err
-		}
-		if entry.CreatedAt, err = parseTime(createdAtStr); err != nil {
-			// created_at이 파싱 실패해도 계속 진행
-			entry.CreatedAt = time.Time{}
-		}
-
-		entries = append(entries, entry)
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w for \"%s\" (%s)", ErrNotFound, title, mediaType)
 	}
 
-	return entries, rows.Err()
+	return entries, nil
 }
 
-func (s *Storage) GetYears() ([]int, error) {
-	query := `
-	SELECT DISTINCT strftime('%Y', date_watched) as year
-	FROM media
-	ORDER BY year DESC
-	`
+// GetByID ID로 항목 조회
+func (s *Storage) GetByID(id int) (models.MediaEntry, error) {
+	query := `SELECT ` + entryColumns + ` FROM media WHERE id = ? AND deleted_at IS NULL`
 
-	rows, err := s.db.Query(query)
+	entries, err := s.queryEntries(query, id)
 	if err != nil {
-		return nil, err
+		return models.MediaEntry{}, err
 	}
-	defer rows.Close()
-
-	var years []int
-	for rows.Next() {
-		var yearStr string // 문자열로 먼저 받아보기
-		if err := rows.Scan(&yearStr); err != nil {
-			return nil, err
-		}
-
-		// 문자열을 정수로 변환
-		var year int
-		if _, err := fmt.Sscanf(yearStr, "%d", &year); err != nil {
-			continue // 파싱 실패 시 건너뛰기
-		}
-
-		years = append(years, year)
+	if len(entries) == 0 {
+		return models.MediaEntry{}, fmt.Errorf("%w with ID %d", ErrNotFound, id)
 	}
-
-	return years, rows.Err()
+	return entries[0], nil
 }
 
-func (s *Storage) FindAllByTitleAndType(title string, mediaType models.MediaType) ([]models.MediaEntry, error) {
-	query := `
-	SELECT id, title, type, rating, comment, date_watched, created_at
+// FindAllByTitle 타입과 무관하게 제목이 일치하는 항목 조회
+func (s *Storage) FindAllByTitle(title string) ([]models.MediaEntry, error) {
+	query := `SELECT ` + entryColumns + `
 	FROM media
-	WHERE title = ? AND type = ?
+	WHERE title = ? AND deleted_at IS NULL
 	ORDER BY id DESC
 	`
 
-	rows, err := s.db.Query(query, title, string(mediaType))
+	entries, err := s.queryEntries(query, title)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var entries []models.MediaEntry
-	for rows.Next() {
-		var entry models.MediaEntry
-		var typeStr, watchedStr, createdStr string
-
-		if err := rows.Scan(
-			&entry.ID, &entry.Title, &typeStr, &entry.Rating, &entry.Comment, &watchedStr, &createdStr,
-		); err != nil {
-			return nil, err
-		}
-
-		entry.Type = models.MediaType(typeStr)
-		entry.DateWatched, _ = parseTime(watchedStr)
-		entry.CreatedAt, _ = parseTime(createdStr)
-
-		entries = append(entries, entry)
-	}
 
 	if len(entries) == 0 {
-		return nil, fmt.Errorf("entry not found for \"%s\" (%s)", title, mediaType)
+		return nil, fmt.Errorf("%w for \"%s\"", ErrNotFound, title)
 	}
 
 	return entries, nil
 }
 
-// 업데이트: ID 기반
+// 업데이트: ID 기반 (시청일은 entry 값 유지, 수정 시각만 갱신, 감상문도 entry 값으로 덮어씀)
 func (s *Storage) UpdateEntry(id int, entry models.MediaEntry) error {
-	query := `
-	UPDATE media 
-	SET title = ?, type = ?, rating = ?, comment = ?, date_watched = ?
-	WHERE id = ?
-	`
-
-	now := time.Now().Format("2006-01-02 15:04:05")
-	result, err := s.db.Exec(query, entry.Title, string(entry.Type), entry.Rating, entry.Comment, now, id)
-	if err != nil {
-		return err
+	var watched interface{}
+	if !entry.DateWatched.IsZero() {
+		watched = formatTime(entry.DateWatched)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
+	return s.editEntry(id, "Edited", func(tx *sql.Tx) (sql.Result, error) {
+		return tx.Exec(`
+		UPDATE media
+		SET title = ?, type = ?, rating = ?, comment = ?, review = ?, date_watched = COALESCE(?, date_watched), updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+		`, entry.Title, string(entry.Type), entry.Rating, entry.Comment, entry.Review, watched, formatTime(time.Now()), id)
+	})
+}
+
+// 한 항목을 바꾸는 update를 작업 기록과 함께 한 트랜잭션으로 실행 (항목이 없으면 ErrNotFound)
+func (s *Storage) editEntry(id int, verb string, update func(tx *sql.Tx) (sql.Result, error)) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		before, err := entriesByIDs(tx, []int{id})
+		if err != nil {
+			return err
+		}
+
+		result, err := update(tx)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 || len(before) == 0 {
+			return fmt.Errorf("%w with ID %d", ErrNotFound, id)
+		}
+
+		return recordOperation(tx, models.OperationEdit, entriesSummary(verb, before), before, []int{id})
+	})
+}
+
+// ReplaceEntry 항목의 모든 필드와 태그를 한 트랜잭션으로 교체 (생성 시각은 유지, 수정 시각만 갱신)
+func (s *Storage) ReplaceEntry(id int, entry models.MediaEntry) error {
+	if entry.Status == "" {
+		entry.Status = models.StatusCompleted
+	}
+	if err := validateProgress(entry); err != nil {
 		return err
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("no entry found with ID %d", id)
-	}
+	return s.editEntry(id, "Edited", func(tx *sql.Tx) (sql.Result, error) {
+		result, err := tx.Exec(`
+		UPDATE media
+		SET title = ?, type = ?, rating = ?, comment = ?, review = ?, date_watched = ?, updated_at = ?,
+			status = ?, season = ?, episodes_watched = ?, total_episodes = ?
+		WHERE id = ? AND deleted_at IS NULL
+		`, entry.Title, string(entry.Type), entry.Rating, entry.Comment, entry.Review,
+			formatTime(entry.DateWatched), formatTime(time.Now()),
+			string(entry.Status), entry.Season, entry.EpisodesWatched, entry.TotalEpisodes, id)
+		if err != nil {
+			return nil, err
+		}
 
-	return nil
+		if _, err := tx.Exec(`DELETE FROM media_tags WHERE media_id = ?`, id); err != nil {
+			return nil, err
+		}
+		return result, attachTags(tx, id, entry.Tags)
+	})
 }
 
-func (s *Storage) DeleteByID(id int) (int64, error) {
-	query := `DELETE FROM media WHERE id = ?`
-	result, err := s.db.Exec(query, id)
-	if err != nil {
-		return 0, err
+// UpdateProgress 시청 상태와 회차 진행 상황만 갱신
+func (s *Storage) UpdateProgress(id int, entry models.MediaEntry) error {
+	if err := validateProgress(entry); err != nil {
+		return err
 	}
-	return result.RowsAffected()
+	return s.editEntry(id, "Updated progress of", func(tx *sql.Tx) (sql.Result, error) {
+		return tx.Exec(`
+		UPDATE media
+		SET status = ?, season = ?, episodes_watched = ?, total_episodes = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+		`, string(entry.Status), entry.Season, entry.EpisodesWatched, entry.TotalEpisodes, formatTime(time.Now()), id)
+	})
 }
 
-func (s *Storage) DeleteAll() (int64, error) {
-	query := `DELETE FROM media`
-	result, err := s.db.Exec(query)
-	if err != nil {
-		return 0, err
+// 시즌과 회차 수는 음수일 수 없고, 전체 회차 수를 알면 본 회차가 그보다 많을 수 없음
+func validateProgress(entry models.MediaEntry) error {
+	switch {
+	case entry.Season < 0 || entry.EpisodesWatched < 0 || entry.TotalEpisodes < 0:
+		return fmt.Errorf("%w: season and episode counts must not be negative", ErrInvalidProgress)
+	case entry.TotalEpisodes > 0 && entry.EpisodesWatched > entry.TotalEpisodes:
+		return fmt.Errorf("%w: %d episodes watched but the season only has %d",
+			ErrInvalidProgress, entry.EpisodesWatched, entry.TotalEpisodes)
 	}
-	return result.RowsAffected()
+	return nil
 }
 
-func (s *Storage) GetStats() (map[string]interface{}, error) {
-	stats := make(map[string]interface{})
+// DeleteByID 항목 하나를 휴지통으로 옮김
+func (s *Storage) DeleteByID(id int) (int64, error) {
+	deleted, err := s.DeleteByIDs([]int{id})
+	return int64(len(deleted)), err
+}
 
-	// Total counts
-	var totalMovies, totalDramas int
-	err := s.db.QueryRow("SELECT COUNT(*) FROM media WHERE type = 'movie'").Scan(&totalMovies)
-	if err != nil {
-		return nil, err
-	}
-	err = s.db.QueryRow("SELECT COUNT(*) FROM media WHERE type = 'drama'").Scan(&totalDramas)
-	if err != nil {
-		return nil, err
-	}
-	stats["total_movies"] = totalMovies
-	stats["total_dramas"] = totalDramas
-
-	// Average ratings
-	var avgMovieRating, avgDramaRating, avgOverallRating float64
-	s.db.QueryRow("SELECT AVG(rating) FROM media WHERE type = 'movie' AND rating > 0").Scan(&avgMovieRating)
-	s.db.QueryRow("SELECT AVG(rating) FROM media WHERE type = 'drama' AND rating > 0").Scan(&avgDramaRating)
-	s.db.QueryRow("SELECT AVG(rating) FROM media WHERE rating > 0").Scan(&avgOverallRating)
-
-	stats["avg_movie_rating"] = avgMovieRating
-	stats["avg_drama_rating"] = avgDramaRating
-	stats["avg_overall_rating"] = avgOverallRating
-
-	// Rating distribution
-	ratingDistribution := make(map[string]int)
-	rows, err := s.db.Query(`
-		SELECT 
-			CASE 
-				WHEN rating >= 4.5 THEN '4.5'
-				WHEN rating >= 4.0 THEN '4.0'
-				WHEN rating >= 3.5 THEN '3.5'
-				WHEN rating >= 3.0 THEN '3.0'
-				WHEN rating >= 2.5 THEN '2.5'
-				WHEN rating >= 2.0 THEN '2.0'
-				WHEN rating >= 1.5 THEN '1.5'
-				WHEN rating >= 1.0 THEN '1.0'
-				ELSE '0.5'
-			END as rating_range,
-			COUNT(*) as count
-		FROM media
-		GROUP BY rating_range
-		ORDER BY rating_range DESC
-	`)
-	if err == nil {
-		defer rows.Close()
-		for rows.Next() {
-			var ratingRange string
-			var count int
-			if err := rows.Scan(&ratingRange, &count); err == nil {
-				ratingDistribution[ratingRange] = count
+// DeleteByIDs 여러 항목을 하나의 트랜잭션으로 휴지통에 옮기고 실제로 옮긴 ID 반환
+// 이미 휴지통에 있거나 없는 ID는 건너뜀
+func (s *Storage) DeleteByIDs(ids []int) ([]int, error) {
+	var deleted []int
+	err := s.withTx(func(tx *sql.Tx) error {
+		found, err := entriesByIDs(tx, ids)
+		if err != nil {
+			return err
+		}
+
+		var before []models.MediaEntry
+		for _, entry := range found {
+			if entry.DeletedAt.IsZero() {
+				before = append(before, entry)
 			}
 		}
-	}
-	stats["rating_distribution"] = ratingDistribution
-
-	// Yearly breakdown
-	yearlyStats := make(map[string]interface{})
-	yearRows, err := s.db.Query(`
-		SELECT 
-			strftime('%Y', date_watched) as year,
-			COUNT(CASE WHEN type = 'movie' THEN 1 END) as movies,
-			COUNT(CASE WHEN type = 'drama' THEN 1 END) as dramas,
-			AVG(rating) as avg_rating
-		FROM media
-		GROUP BY year
-		ORDER BY year DESC
-	`)
-	if err == nil {
-		defer yearRows.Close()
-		for yearRows.Next() {
-			var year string
-			var movies, dramas int
-			var avgRating float64
-			if err := yearRows.Scan(&year, &movies, &dramas, &avgRating); err == nil {
-				yearlyStats[year] = map[string]interface{}{
-					"movies":     movies,
-					"dramas":     dramas,
-					"avg_rating": avgRating,
-				}
+
+		stmt, err := tx.Prepare(`UPDATE media SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`)
+		if err != nil {
+			return err
+		}
+		defer stmt.Close()
+
+		now := formatTime(time.Now())
+		for _, id := range ids {
+			result, err := stmt.Exec(now, id)
+			if err != nil {
+				return err
+			}
+			if n, err := result.RowsAffected(); err != nil {
+				return err
+			} else if n > 0 {
+				deleted = append(deleted, id)
 			}
 		}
+
+		return recordOperation(tx, models.OperationDelete, entriesSummary("Deleted", before), before, deleted)
+	})
+	if err != nil {
+		return nil, err
 	}
-	stats["yearly_stats"] = yearlyStats
+	return deleted, nil
+}
+
+// DeleteAll 휴지통에 없는 모든 항목을 휴지통으로 옮김 (작업 기록에 남으므로 undo로 되돌릴 수 있음)
+func (s *Storage) DeleteAll() (int64, error) {
+	var ids []int
+	err := s.withTx(func(tx *sql.Tx) error {
+		before, err := queryEntriesIn(tx, `SELECT `+entryColumns+` FROM media WHERE deleted_at IS NULL ORDER BY id`)
+		if err != nil {
+			return err
+		}
 
-	// Last watched
-	var lastWatched string
-	s.db.QueryRow("SELECT date_watched FROM media ORDER BY date_watched DESC LIMIT 1").Scan(&lastWatched)
-	stats["last_watched"] = lastWatched
+		if _, err := tx.Exec(`UPDATE media SET deleted_at = ? WHERE deleted_at IS NULL`, formatTime(time.Now())); err != nil {
+			return err
+		}
 
-	return stats, nil
+		for _, entry := range before {
+			ids = append(ids, entry.ID)
+		}
+		return recordOperation(tx, models.OperationDelete, fmt.Sprintf("Deleted all %d entries", len(before)), before, ids)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(ids)), nil
 }
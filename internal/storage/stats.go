@@ -0,0 +1,193 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// GetStats 컬렉션 통계 집계 (평점은 정규화 값, 평점 분포는 scale의 구간으로 묶음)
+func (s *Storage) GetStats(scale models.RatingScale) (models.Stats, error) {
+	var stats models.Stats
+
+	// 전체 개수와 평균 평점 (평점 0은 평균에서 제외)
+	err := s.db.QueryRow(`
+		SELECT COUNT(*), COALESCE(AVG(CASE WHEN rating > 0 THEN rating END), 0)
+		FROM media
+		WHERE deleted_at IS NULL
+	`).Scan(&stats.TotalEntries, &stats.AvgRating)
+	if err != nil {
+		return models.Stats{}, fmt.Errorf("failed to count entries: %w", err)
+	}
+
+	// 다시 본 작품 (제목과 타입이 같은 항목은 같은 작품의 시청 기록)
+	err = s.db.QueryRow(`
+		SELECT COUNT(*), COALESCE(SUM(CASE WHEN n > 1 THEN 1 ELSE 0 END), 0), COALESCE(SUM(n - 1), 0)
+		FROM (SELECT COUNT(*) AS n FROM media WHERE deleted_at IS NULL GROUP BY title, type)
+	`).Scan(&stats.TotalTitles, &stats.RewatchedTitles, &stats.Rewatches)
+	if err != nil {
+		return models.Stats{}, fmt.Errorf("failed to count rewatches: %w", err)
+	}
+
+	// 타입별
+	var types []models.TypeStats
+	rows, err := s.db.Query(`
+		SELECT type, COUNT(*), COALESCE(AVG(CASE WHEN rating > 0 THEN rating END), 0)
+		FROM media
+		WHERE deleted_at IS NULL
+		GROUP BY type
+	`)
+	if err != nil {
+		return models.Stats{}, fmt.Errorf("failed to count entries by type: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var ts models.TypeStats
+		if err := rows.Scan(&ts.Type, &ts.Count, &ts.AvgRating); err != nil {
+			return models.Stats{}, err
+		}
+		types = append(types, ts)
+	}
+	if err := rows.Err(); err != nil {
+		return models.Stats{}, err
+	}
+	stats.Types = orderTypeStats(types)
+
+	// 평점 분포 (척도별 구간, 평가하지 않은 항목은 제외)
+	distribution, err := s.ratingDistribution(scale, stats.TotalEntries)
+	if err != nil {
+		return models.Stats{}, err
+	}
+	stats.RatingDistribution = distribution
+
+	yearly, err := s.yearlyStats()
+	if err != nil {
+		return models.Stats{}, err
+	}
+	stats.Yearly = yearly
+
+	if stats.Tags, err = s.ListTags(); err != nil {
+		return models.Stats{}, err
+	}
+
+	if stats.Watchlist, err = s.watchlistStats(); err != nil {
+		return models.Stats{}, err
+	}
+
+	// 마지막 시청일
+	var lastWatched sql.NullString
+	err = s.db.QueryRow("SELECT MAX(date_watched) FROM media WHERE deleted_at IS NULL").Scan(&lastWatched)
+	if err != nil {
+		return models.Stats{}, fmt.Errorf("failed to load last watched date: %w", err)
+	}
+	if lastWatched.Valid {
+		if stats.LastWatched, err = parseTime(lastWatched.String); err != nil {
+			return models.Stats{}, err
+		}
+	}
+
+	return stats, nil
+}
+
+// 평점 분포 (높은 평점순, 비율은 전체 항목 대비)
+func (s *Storage) ratingDistribution(scale models.RatingScale, total int) ([]models.RatingBucket, error) {
+	rows, err := s.db.Query(`SELECT rating, COUNT(*) FROM media WHERE rating > 0 AND deleted_at IS NULL GROUP BY rating`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute rating distribution: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[float64]int)
+	for rows.Next() {
+		var rating float64
+		var count int
+		if err := rows.Scan(&rating, &count); err != nil {
+			return nil, err
+		}
+		counts[scale.Bucket(rating)] += count
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	buckets := make([]models.RatingBucket, 0, len(counts))
+	for rating, count := range counts {
+		bucket := models.RatingBucket{Rating: rating, Count: count}
+		if total > 0 {
+			bucket.Percentage = float64(count) / float64(total) * 100
+		}
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].Rating > buckets[j].Rating
+	})
+	return buckets, nil
+}
+
+// 시청 연도별 통계 (최근 연도순)
+func (s *Storage) yearlyStats() ([]models.YearStats, error) {
+	rows, err := s.db.Query(`
+		SELECT CAST(strftime('%Y', date_watched) AS INTEGER) AS year, type, COUNT(*),
+			COALESCE(AVG(CASE WHEN rating > 0 THEN rating END), 0), SUM(rating)
+		FROM media
+		WHERE deleted_at IS NULL
+		GROUP BY year, type
+		ORDER BY year DESC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute yearly stats: %w", err)
+	}
+	defer rows.Close()
+
+	var yearly []models.YearStats
+	var sums []float64
+	for rows.Next() {
+		var year int
+		var ts models.TypeStats
+		var sum float64
+		if err := rows.Scan(&year, &ts.Type, &ts.Count, &ts.AvgRating, &sum); err != nil {
+			return nil, err
+		}
+		if len(yearly) == 0 || yearly[len(yearly)-1].Year != year {
+			yearly = append(yearly, models.YearStats{Year: year})
+			sums = append(sums, 0)
+		}
+		last := len(yearly) - 1
+		yearly[last].Count += ts.Count
+		yearly[last].Types = append(yearly[last].Types, ts)
+		sums[last] += sum
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// 연도 평균은 평점 0 포함, 타입은 등록 순서로 정렬
+	for i := range yearly {
+		yearly[i].AvgRating = sums[i] / float64(yearly[i].Count)
+		yearly[i].Types = orderTypeStats(yearly[i].Types)
+	}
+	return yearly, nil
+}
+
+// 등록된 타입 순서로 정렬 (등록되지 않은 타입은 뒤에 이름순)
+func orderTypeStats(types []models.TypeStats) []models.TypeStats {
+	order := make(map[models.MediaType]int)
+	for i, t := range models.MediaTypes() {
+		order[t] = i
+	}
+
+	sort.Slice(types, func(i, j int) bool {
+		oi, iKnown := order[types[i].Type]
+		oj, jKnown := order[types[j].Type]
+		if iKnown != jKnown {
+			return iKnown
+		}
+		if iKnown {
+			return oi < oj
+		}
+		return types[i].Type < types[j].Type
+	})
+	return types
+}
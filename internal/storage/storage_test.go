@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"testing"
+)
+
+// 임시 HOME에 새 DB를 만들어 최신 스키마까지 마이그레이션
+func newTestStorage(t *testing.T) *Storage {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+
+	store, err := NewStorage()
+	if err != nil {
+		t.Fatalf("NewStorage: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
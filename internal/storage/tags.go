@@ -0,0 +1,279 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// ErrTagNotFound 해당 이름의 태그가 없음
+var ErrTagNotFound = errors.New("tag not found")
+
+// ErrTagExists 바꾸려는 이름의 태그가 이미 있음 (merge로 합쳐야 함)
+var ErrTagExists = errors.New("tag already exists")
+
+// ListTags 모든 태그와 항목 수, 평균 평점 (많이 쓰인 순, 같으면 이름순)
+func (s *Storage) ListTags() ([]models.TagStats, error) {
+	rows, err := s.db.Query(`
+		SELECT t.name, COUNT(*), COALESCE(AVG(CASE WHEN m.rating > 0 THEN m.rating END), 0)
+		FROM tags t
+		JOIN media_tags mt ON mt.tag_id = t.id
+		JOIN media m ON m.id = mt.media_id
+		WHERE m.deleted_at IS NULL
+		GROUP BY t.id
+		ORDER BY COUNT(*) DESC, t.name COLLATE NOCASE ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []models.TagStats
+	for rows.Next() {
+		var tag models.TagStats
+		if err := rows.Scan(&tag.Name, &tag.Count, &tag.AvgRating); err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+	return tags, rows.Err()
+}
+
+// SetTags 항목의 태그를 tags로 교체
+func (s *Storage) SetTags(id int, tags []string) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		return recordTagEdit(tx, []int{id}, "Tagged", func() error {
+			if _, err := tx.Exec(`DELETE FROM media_tags WHERE media_id = ?`, id); err != nil {
+				return err
+			}
+			return attachTags(tx, id, tags)
+		})
+	})
+}
+
+// AddTags 항목에 태그 추가 (이미 있는 태그는 무시)
+func (s *Storage) AddTags(id int, tags []string) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		return recordTagEdit(tx, []int{id}, "Tagged", func() error {
+			return attachTags(tx, id, tags)
+		})
+	})
+}
+
+// RemoveTags 항목에서 태그 제거하고 실제로 제거된 개수 반환
+func (s *Storage) RemoveTags(id int, tags []string) (int, error) {
+	removed := 0
+	err := s.withTx(func(tx *sql.Tx) error {
+		return recordTagEdit(tx, []int{id}, "Untagged", func() error {
+			for _, tag := range tags {
+				result, err := tx.Exec(`
+					DELETE FROM media_tags
+					WHERE media_id = ? AND tag_id = (SELECT id FROM tags WHERE name = ?)
+				`, id, tag)
+				if err != nil {
+					return err
+				}
+				n, err := result.RowsAffected()
+				if err != nil {
+					return err
+				}
+				removed += int(n)
+			}
+			return nil
+		})
+	})
+	return removed, err
+}
+
+// RenameTag 태그 이름 변경 (대소문자만 바꾸는 것도 가능)
+func (s *Storage) RenameTag(oldName, newName string) error {
+	if err := validateTagName(newName); err != nil {
+		return err
+	}
+
+	return s.withTx(func(tx *sql.Tx) error {
+		oldID, err := tagID(tx, oldName)
+		if err != nil {
+			return err
+		}
+
+		existingID, err := tagID(tx, newName)
+		if err == nil && existingID != oldID {
+			return fmt.Errorf("%w: \"%s\"", ErrTagExists, newName)
+		}
+		if err != nil && !errors.Is(err, ErrTagNotFound) {
+			return err
+		}
+
+		ids, err := taggedEntryIDs(tx, []interface{}{oldID})
+		if err != nil {
+			return err
+		}
+		verb := fmt.Sprintf("Renamed tag %q to %q on", oldName, newName)
+		return recordTagEdit(tx, ids, verb, func() error {
+			_, err := tx.Exec(`UPDATE tags SET name = ? WHERE id = ?`, newName, oldID)
+			return err
+		})
+	})
+}
+
+// MergeTags sources 태그를 모두 target으로 합치고 영향을 받은 항목 수 반환
+// target이 없으면 새로 만들고, 합쳐진 태그는 삭제됨
+func (s *Storage) MergeTags(sources []string, target string) (int, error) {
+	affected := 0
+	err := s.withTx(func(tx *sql.Tx) error {
+		targetID, err := ensureTag(tx, target)
+		if err != nil {
+			return err
+		}
+
+		var sourceIDs []interface{}
+		for _, source := range sources {
+			sourceID, err := tagID(tx, source)
+			if err != nil {
+				return err
+			}
+			if sourceID != targetID {
+				sourceIDs = append(sourceIDs, sourceID)
+			}
+		}
+		if len(sourceIDs) == 0 {
+			return nil
+		}
+
+		ids, err := taggedEntryIDs(tx, sourceIDs)
+		if err != nil {
+			return err
+		}
+		affected = len(ids)
+
+		in := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(sourceIDs)), ", ") + ")"
+		verb := fmt.Sprintf("Merged tags %s into %q on", quoteTags(sources), target)
+		return recordTagEdit(tx, ids, verb, func() error {
+			if _, err := tx.Exec(`
+				INSERT OR IGNORE INTO media_tags (media_id, tag_id)
+				SELECT media_id, ? FROM media_tags WHERE tag_id IN `+in,
+				append([]interface{}{targetID}, sourceIDs...)...); err != nil {
+				return err
+			}
+			// 연결이 모두 사라지면 트리거가 원래 태그를 삭제
+			_, err := tx.Exec(`DELETE FROM media_tags WHERE tag_id IN `+in, sourceIDs...)
+			return err
+		})
+	})
+	return affected, err
+}
+
+// ids 항목의 태그를 apply로 바꾸고, 태그가 달라진 항목만 작업 전후 상태와 함께 기록
+// (기록이 없으면 이전 편집을 되돌릴 때 나중에 바꾼 태그까지 사라짐)
+func recordTagEdit(tx *sql.Tx, ids []int, verb string, apply func() error) error {
+	before, err := entriesByIDs(tx, ids)
+	if err != nil {
+		return err
+	}
+	if err := apply(); err != nil {
+		return err
+	}
+	after, err := entriesByIDs(tx, ids)
+	if err != nil {
+		return err
+	}
+
+	afterTags := make(map[int]string, len(after))
+	for _, entry := range after {
+		afterTags[entry.ID] = tagKey(entry.Tags)
+	}
+	var changed []models.MediaEntry
+	var changedIDs []int
+	for _, entry := range before {
+		if tagKey(entry.Tags) != afterTags[entry.ID] {
+			changed = append(changed, entry)
+			changedIDs = append(changedIDs, entry.ID)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	return recordOperation(tx, models.OperationEdit, entriesSummary(verb, changed), changed, changedIDs)
+}
+
+// 순서와 관계없이 태그 목록을 비교하기 위한 키
+func tagKey(tags []string) string {
+	sorted := append([]string(nil), tags...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, tagSeparator)
+}
+
+// 태그 중 하나라도 가진 항목 ID (휴지통 항목 포함)
+func taggedEntryIDs(tx *sql.Tx, tagIDs []interface{}) ([]int, error) {
+	in := "(" + strings.TrimSuffix(strings.Repeat("?, ", len(tagIDs)), ", ") + ")"
+	rows, err := tx.Query(`SELECT DISTINCT media_id FROM media_tags WHERE tag_id IN `+in+` ORDER BY media_id`, tagIDs...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func quoteTags(tags []string) string {
+	quoted := make([]string, len(tags))
+	for i, tag := range tags {
+		quoted[i] = fmt.Sprintf("%q", tag)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// 항목에 태그 연결 (없는 태그는 새로 만듦)
+func attachTags(tx *sql.Tx, mediaID int, tags []string) error {
+	for _, tag := range tags {
+		id, err := ensureTag(tx, tag)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO media_tags (media_id, tag_id) VALUES (?, ?)`, mediaID, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 이름으로 태그 ID 조회, 없으면 생성
+func ensureTag(tx *sql.Tx, name string) (int, error) {
+	if err := validateTagName(name); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(`INSERT OR IGNORE INTO tags (name) VALUES (?)`, name); err != nil {
+		return 0, err
+	}
+	return tagID(tx, name)
+}
+
+// 태그 이름은 비어 있거나 구분자(쉼표)를 포함할 수 없음
+func validateTagName(name string) error {
+	if strings.TrimSpace(name) == "" || strings.Contains(name, tagSeparator) {
+		return fmt.Errorf("invalid tag name %q", name)
+	}
+	return nil
+}
+
+func tagID(tx *sql.Tx, name string) (int, error) {
+	var id int
+	err := tx.QueryRow(`SELECT id FROM tags WHERE name = ?`, name).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, fmt.Errorf("%w: \"%s\"", ErrTagNotFound, name)
+	}
+	return id, err
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// ListTrash 휴지통의 항목 (최근에 삭제한 순)
+func (s *Storage) ListTrash() ([]models.MediaEntry, error) {
+	return s.queryEntries(`SELECT ` + entryColumns + `
+	FROM media
+	WHERE deleted_at IS NOT NULL
+	ORDER BY deleted_at DESC, id DESC
+	`)
+}
+
+// RestoreEntries 휴지통의 항목을 되살리고 실제로 되살린 ID 반환 (휴지통에 없는 ID는 건너뜀)
+func (s *Storage) RestoreEntries(ids []int) ([]int, error) {
+	var restored []int
+	err := s.withTx(func(tx *sql.Tx) error {
+		found, err := entriesByIDs(tx, ids)
+		if err != nil {
+			return err
+		}
+
+		var before []models.MediaEntry
+		for _, entry := range found {
+			if !entry.DeletedAt.IsZero() {
+				before = append(before, entry)
+				restored = append(restored, entry.ID)
+			}
+		}
+		for _, id := range restored {
+			if _, err := tx.Exec(`UPDATE media SET deleted_at = NULL WHERE id = ?`, id); err != nil {
+				return err
+			}
+		}
+
+		return recordOperation(tx, models.OperationEdit, entriesSummary("Restored", before), before, restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return restored, nil
+}
+
+// 휴지통에서 cutoff 이전에 삭제한 항목 조건 (별칭 m, cutoff가 zero면 전부)
+// deleted_at은 로컬 시각 문자열이므로 cutoff도 로컬 시각으로 맞춰 비교
+func purgeFilter(cutoff time.Time) (string, []interface{}) {
+	var w whereBuilder
+	w.add("m.deleted_at IS NOT NULL")
+	if !cutoff.IsZero() {
+		w.add("m.deleted_at < ?", formatTime(cutoff.In(time.Local)))
+	}
+	return w.String(), w.args
+}
+
+// CountTrash PurgeTrash(cutoff)로 영구 삭제될 항목 수
+func (s *Storage) CountTrash(cutoff time.Time) (int, error) {
+	where, args := purgeFilter(cutoff)
+	var count int
+	err := s.db.QueryRow(`SELECT COUNT(*) FROM media AS m `+where, args...).Scan(&count)
+	return count, err
+}
+
+// PurgeTrash 휴지통에서 cutoff 이전에 삭제한 항목을 영구 삭제하고 삭제한 수 반환 (cutoff가 zero면 전부)
+// 영구 삭제할 항목이 들어 있는 작업 기록도 지워서 undo로 되살아나지 않게 함
+func (s *Storage) PurgeTrash(cutoff time.Time) (int, error) {
+	where, args := purgeFilter(cutoff)
+
+	var purged int64
+	err := s.withTx(func(tx *sql.Tx) error {
+		references := func(column string) string {
+			return `EXISTS (SELECT 1 FROM json_each(journal.` + column + `) j
+				JOIN media m ON m.id = json_extract(j.value, '$.id') ` + where + `)`
+		}
+		if _, err := tx.Exec(`DELETE FROM journal WHERE `+references("before_image")+` OR `+references("after_image"),
+			append(append([]interface{}{}, args...), args...)...); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(`DELETE FROM media AS m `+where, args...)
+		if err != nil {
+			return err
+		}
+		purged, err = result.RowsAffected()
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return int(purged), nil
+}
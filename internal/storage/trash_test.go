@@ -0,0 +1,85 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+func TestPurgeTrashCountMatchesPurgeOutsideUTC(t *testing.T) {
+	local := time.Local
+	time.Local = time.FixedZone("PDT", -7*60*60)
+	t.Cleanup(func() { time.Local = local })
+
+	store := newTestStorage(t)
+	var ids []int
+	for _, title := range []string{"Old", "Recent"} {
+		id, err := store.AddEntry(models.MediaEntry{Title: title, Type: models.Movie, Rating: 3})
+		if err != nil {
+			t.Fatalf("AddEntry: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if _, err := store.DeleteByIDs(ids); err != nil {
+		t.Fatalf("DeleteByIDs: %v", err)
+	}
+	twoHoursAgo := formatTime(time.Now().Add(-2 * time.Hour))
+	if _, err := store.db.Exec(`UPDATE media SET deleted_at = ? WHERE id = ?`, twoHoursAgo, ids[0]); err != nil {
+		t.Fatalf("backdate deleted_at: %v", err)
+	}
+
+	trash, err := store.ListTrash()
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	if len(trash) != 2 {
+		t.Fatalf("ListTrash returned %d entries, want 2", len(trash))
+	}
+	if since := time.Since(trash[0].DeletedAt); since < 0 || since > time.Minute {
+		t.Errorf("recent entry deleted %v ago, want just now", since)
+	}
+
+	cutoff := time.Now().Add(-time.Hour)
+	count, err := store.CountTrash(cutoff)
+	if err != nil {
+		t.Fatalf("CountTrash: %v", err)
+	}
+	purged, err := store.PurgeTrash(cutoff)
+	if err != nil {
+		t.Fatalf("PurgeTrash: %v", err)
+	}
+	if count != 1 || purged != 1 {
+		t.Fatalf("CountTrash = %d, PurgeTrash = %d, want 1 and 1", count, purged)
+	}
+
+	trash, err = store.ListTrash()
+	if err != nil {
+		t.Fatalf("ListTrash: %v", err)
+	}
+	if len(trash) != 1 || trash[0].ID != ids[1] {
+		t.Errorf("trash after purge = %+v, want only [%d] Recent", trash, ids[1])
+	}
+}
+
+func TestCountTrashWithoutCutoff(t *testing.T) {
+	store := newTestStorage(t)
+	id, err := store.AddEntry(models.MediaEntry{Title: "Dune", Type: models.Movie})
+	if err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+	if _, err := store.AddEntry(models.MediaEntry{Title: "Kept", Type: models.Movie}); err != nil {
+		t.Fatalf("AddEntry: %v", err)
+	}
+	if _, err := store.DeleteByIDs([]int{id}); err != nil {
+		t.Fatalf("DeleteByIDs: %v", err)
+	}
+
+	count, err := store.CountTrash(time.Time{})
+	if err != nil {
+		t.Fatalf("CountTrash: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("CountTrash = %d, want 1", count)
+	}
+}
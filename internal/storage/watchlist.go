@@ -0,0 +1,183 @@
+package storage
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// ErrWatchlistItemNotFound 볼 예정 목록에 해당 항목이 없음 (이미 옮긴 항목 포함)
+var ErrWatchlistItemNotFound = errors.New("watchlist item not found")
+
+const watchlistColumns = `id, title, type, priority, recommended_by, note, added_at`
+
+// AddWatchlistItem 볼 예정 목록에 추가하고 새 항목의 ID 반환 (추가일이 비어 있으면 현재 시각)
+func (s *Storage) AddWatchlistItem(item models.WatchlistItem) (int, error) {
+	if item.AddedAt.IsZero() {
+		item.AddedAt = time.Now()
+	}
+	if item.Priority == 0 {
+		item.Priority = models.PriorityNormal
+	}
+
+	result, err := s.db.Exec(`
+	INSERT INTO watchlist (title, type, priority, recommended_by, note, added_at)
+	VALUES (?, ?, ?, ?, ?, ?)
+	`, item.Title, string(item.Type), int(item.Priority), item.RecommendedBy, item.Note, formatTime(item.AddedAt))
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	return int(id), err
+}
+
+// ListWatchlist 아직 보지 않은 항목 (우선순위 높은 순, 같으면 먼저 추가한 순)
+// mediaType이 비어 있으면 모든 타입
+func (s *Storage) ListWatchlist(mediaType models.MediaType) ([]models.WatchlistItem, error) {
+	var w whereBuilder
+	w.add("promoted_at IS NULL")
+	if mediaType != "" {
+		w.add("type = ?", string(mediaType))
+	}
+
+	rows, err := s.db.Query(`SELECT `+watchlistColumns+` FROM watchlist `+w.String()+`
+		ORDER BY priority DESC, added_at ASC, id ASC`, w.args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list watchlist: %w", err)
+	}
+	defer rows.Close()
+
+	var items []models.WatchlistItem
+	for rows.Next() {
+		item, err := scanWatchlistItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// GetWatchlistItem ID로 아직 보지 않은 항목 조회
+func (s *Storage) GetWatchlistItem(id int) (models.WatchlistItem, error) {
+	rows, err := s.db.Query(`SELECT `+watchlistColumns+` FROM watchlist WHERE id = ? AND promoted_at IS NULL`, id)
+	if err != nil {
+		return models.WatchlistItem{}, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return models.WatchlistItem{}, err
+		}
+		return models.WatchlistItem{}, fmt.Errorf("%w with ID %d", ErrWatchlistItemNotFound, id)
+	}
+	return scanWatchlistItem(rows)
+}
+
+// RemoveWatchlistItems 아직 보지 않은 항목을 삭제하고 실제로 삭제된 ID 반환
+func (s *Storage) RemoveWatchlistItems(ids []int) ([]int, error) {
+	var removed []int
+	err := s.withTx(func(tx *sql.Tx) error {
+		for _, id := range ids {
+			result, err := tx.Exec(`DELETE FROM watchlist WHERE id = ? AND promoted_at IS NULL`, id)
+			if err != nil {
+				return err
+			}
+			if n, err := result.RowsAffected(); err != nil {
+				return err
+			} else if n > 0 {
+				removed = append(removed, id)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
+
+// PromoteWatchlistItem 목록의 항목을 본 것으로 기록 (entry를 추가하고 목록에서 옮김)
+// 추가된 항목의 ID 반환
+func (s *Storage) PromoteWatchlistItem(id int, entry models.MediaEntry) (int, error) {
+	now := time.Now()
+	if entry.DateWatched.IsZero() {
+		entry.DateWatched = now
+	}
+	entry.CreatedAt = now
+
+	var mediaID int
+	err := s.withTx(func(tx *sql.Tx) error {
+		var err error
+		if mediaID, err = insertEntry(tx, entry, now); err != nil {
+			return err
+		}
+		journalID, err := insertJournal(tx, models.OperationAdd,
+			fmt.Sprintf("Added [%d] %s from the watchlist", mediaID, entry.Title), nil, []int{mediaID})
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`UPDATE journal SET watchlist_id = ? WHERE id = ?`, id, journalID); err != nil {
+			return err
+		}
+
+		result, err := tx.Exec(`
+		UPDATE watchlist SET media_id = ?, promoted_at = ?
+		WHERE id = ? AND promoted_at IS NULL
+		`, mediaID, formatTime(now), id)
+		if err != nil {
+			return err
+		}
+		if n, err := result.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return fmt.Errorf("%w with ID %d", ErrWatchlistItemNotFound, id)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return mediaID, nil
+}
+
+// 볼 예정 목록 통계 (보기까지 걸린 기간은 옮긴 기록의 시청일 기준, 기록이 지워졌으면 옮긴 시각)
+func (s *Storage) watchlistStats() (models.WatchlistStats, error) {
+	var stats models.WatchlistStats
+	err := s.db.QueryRow(`
+		SELECT
+			COALESCE(SUM(CASE WHEN w.promoted_at IS NULL THEN 1 ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN w.promoted_at IS NOT NULL THEN 1 ELSE 0 END), 0),
+			COALESCE(AVG(CASE WHEN w.promoted_at IS NOT NULL THEN
+				MAX(0, julianday(COALESCE(m.date_watched, w.promoted_at)) - julianday(w.added_at)) END), 0)
+		FROM watchlist w
+		LEFT JOIN media m ON m.id = w.media_id
+	`).Scan(&stats.Backlog, &stats.Watched, &stats.AvgDaysToWatch)
+	if err != nil {
+		return models.WatchlistStats{}, fmt.Errorf("failed to compute watchlist stats: %w", err)
+	}
+	return stats, nil
+}
+
+func scanWatchlistItem(rows *sql.Rows) (models.WatchlistItem, error) {
+	var item models.WatchlistItem
+	var typeStr, addedStr string
+	var recommendedBy, note sql.NullString
+
+	if err := rows.Scan(&item.ID, &item.Title, &typeStr, &item.Priority, &recommendedBy, &note, &addedStr); err != nil {
+		return item, err
+	}
+
+	item.Type = models.MediaType(typeStr)
+	item.RecommendedBy = recommendedBy.String
+	item.Note = note.String
+
+	var err error
+	item.AddedAt, err = parseTime(addedStr)
+	return item, err
+}
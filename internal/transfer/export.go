@@ -0,0 +1,228 @@
+package transfer
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// Format 내보내기/가져오기 파일 형식
+type Format string
+
+const (
+	FormatCSV   Format = "csv"
+	FormatJSON  Format = "json"
+	FormatJSONL Format = "jsonl"
+
+	// Letterboxd diary.csv / ratings.csv 레이아웃 (영화만 해당)
+	FormatLetterboxd Format = "letterboxd"
+)
+
+// Columns 내보내기 파일의 고정 컬럼 이름 (순서 포함)
+// 태그는 쉼표로 구분하고, 회차 정보는 회차를 기록하지 않는 타입이면 0
+var Columns = []string{
+	"id", "title", "type", "rating", "comment", "review", "date_watched", "created_at",
+	"tags", "status", "season", "episodes_watched", "total_episodes",
+}
+
+// Options 읽기/쓰기 공통 설정
+type Options struct {
+	Scale models.RatingScale // 파일의 rating 척도 (Display.RatingScale, Letterboxd는 항상 별 0.5~5)
+}
+
+// 파일에 기록되는 시간 형식
+const timeLayout = time.RFC3339
+
+// ParseFormat 문자열을 Format으로 변환
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(strings.ToLower(strings.TrimSpace(s))); f {
+	case FormatCSV, FormatJSON, FormatJSONL, FormatLetterboxd:
+		return f, nil
+	case "ndjson":
+		return FormatJSONL, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q (expected csv, json, jsonl or letterboxd)", s)
+	}
+}
+
+// FormatFromPath 파일 확장자로 형식 추론
+func FormatFromPath(path string) (Format, bool) {
+	ext := strings.TrimPrefix(filepath.Ext(path), ".")
+	if ext == "" {
+		return "", false
+	}
+	f, err := ParseFormat(ext)
+	return f, err == nil
+}
+
+// Record 파일에 기록되는 한 항목
+type Record struct {
+	ID          int     `json:"id"`
+	Title       string  `json:"title"`
+	Type        string  `json:"type"`
+	Rating      float64 `json:"rating"`
+	Comment     string  `json:"comment"`
+	Review      string  `json:"review"`
+	DateWatched string  `json:"date_watched"`
+	CreatedAt   string  `json:"created_at"`
+
+	Tags            []string `json:"tags"`
+	Status          string   `json:"status"`
+	Season          int      `json:"season"`
+	EpisodesWatched int      `json:"episodes_watched"`
+	TotalEpisodes   int      `json:"total_episodes"`
+}
+
+// NewRecord MediaEntry를 Record로 변환 (정규화 평점은 scale의 값으로)
+func NewRecord(entry models.MediaEntry, scale models.RatingScale) Record {
+	return Record{
+		ID:          entry.ID,
+		Title:       entry.Title,
+		Type:        string(entry.Type),
+		Rating:      scale.Value(entry.Rating),
+		Comment:     entry.Comment,
+		Review:      entry.Review,
+		DateWatched: formatTime(entry.DateWatched),
+		CreatedAt:   formatTime(entry.CreatedAt),
+
+		Tags:            nonNilTags(entry.Tags),
+		Status:          string(entry.Status),
+		Season:          entry.Season,
+		EpisodesWatched: entry.EpisodesWatched,
+		TotalEpisodes:   entry.TotalEpisodes,
+	}
+}
+
+// JSON에서 태그가 없으면 null 대신 []
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// Values Columns 순서대로 문자열 값 반환
+func (r Record) Values() []string {
+	return []string{
+		strconv.Itoa(r.ID),
+		r.Title,
+		r.Type,
+		strconv.FormatFloat(r.Rating, 'f', -1, 64),
+		r.Comment,
+		r.Review,
+		r.DateWatched,
+		r.CreatedAt,
+		strings.Join(r.Tags, ", "),
+		r.Status,
+		strconv.Itoa(r.Season),
+		strconv.Itoa(r.EpisodesWatched),
+		strconv.Itoa(r.TotalEpisodes),
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+// Writer 항목을 한 건씩 기록하는 스트리밍 writer
+type Writer interface {
+	Write(entry models.MediaEntry) error
+	Close() error
+}
+
+// NewWriter 형식에 맞는 Writer 생성
+func NewWriter(w io.Writer, format Format, opts Options) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w, opts)
+	case FormatLetterboxd:
+		return newLetterboxdWriter(w)
+	case FormatJSON:
+		return &jsonWriter{w: w, scale: opts.Scale}, nil
+	case FormatJSONL:
+		return &jsonlWriter{enc: json.NewEncoder(w), scale: opts.Scale}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+type csvWriter struct {
+	w     *csv.Writer
+	scale models.RatingScale
+}
+
+func newCSVWriter(w io.Writer, opts Options) (*csvWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(Columns); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: cw, scale: opts.Scale}, nil
+}
+
+func (c *csvWriter) Write(entry models.MediaEntry) error {
+	return c.w.Write(NewRecord(entry, c.scale).Values())
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// JSON 배열을 한 요소씩 기록
+type jsonWriter struct {
+	w     io.Writer
+	scale models.RatingScale
+	count int
+}
+
+func (j *jsonWriter) Write(entry models.MediaEntry) error {
+	data, err := json.MarshalIndent(NewRecord(entry, j.scale), "  ", "  ")
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n  "
+	if j.count == 0 {
+		prefix = "[\n  "
+	}
+	j.count++
+
+	if _, err := io.WriteString(j.w, prefix); err != nil {
+		return err
+	}
+	_, err = j.w.Write(data)
+	return err
+}
+
+func (j *jsonWriter) Close() error {
+	closing := "\n]\n"
+	if j.count == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(j.w, closing)
+	return err
+}
+
+// 한 줄에 JSON 객체 하나
+type jsonlWriter struct {
+	enc   *json.Encoder
+	scale models.RatingScale
+}
+
+func (j *jsonlWriter) Write(entry models.MediaEntry) error {
+	return j.enc.Encode(NewRecord(entry, j.scale))
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
@@ -0,0 +1,283 @@
+package transfer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// Row 가져오기 파일의 한 행 (Err가 nil이 아니면 유효하지 않은 행)
+type Row struct {
+	Line  int // CSV/JSONL은 파일의 줄 번호, JSON은 배열 인덱스(1부터)
+	Entry models.MediaEntry
+	Err   *utils.AppError
+}
+
+// 컬럼 별칭 → 표준 컬럼 이름
+var columnAliases = map[string]string{
+	"date":       "date_watched",
+	"watched":    "date_watched",
+	"watched_at": "date_watched",
+	"name":       "title",
+}
+
+// 가져오기 시 허용하는 시간 형식
+var importTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// ReadRows 파일을 읽어 행마다 MediaEntry로 변환하고 검증
+func ReadRows(r io.Reader, format Format, opts Options) ([]Row, error) {
+	var raw []rawRow
+	var err error
+
+	switch format {
+	case FormatCSV:
+		raw, err = readCSV(r)
+	case FormatJSON:
+		raw, err = readJSON(r)
+	case FormatJSONL:
+		raw, err = readJSONL(r)
+	case FormatLetterboxd:
+		return readLetterboxd(r)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]Row, 0, len(raw))
+	for _, rr := range raw {
+		entry, appErr := rr.toEntry(opts)
+		rows = append(rows, Row{Line: rr.line, Entry: entry, Err: appErr})
+	}
+	return rows, nil
+}
+
+// 형식과 무관한 중간 표현: 표준 컬럼 이름 → 값
+type rawRow struct {
+	line   int
+	fields map[string]string
+}
+
+func normalizeColumn(name string) string {
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.ReplaceAll(key, " ", "_")
+	if alias, ok := columnAliases[key]; ok {
+		return alias
+	}
+	return key
+}
+
+func readCSV(r io.Reader) ([]rawRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) > 0 {
+		header[0] = strings.TrimPrefix(header[0], "\ufeff")
+	}
+	for i := range header {
+		header[i] = normalizeColumn(header[i])
+	}
+
+	var rows []rawRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		fields := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) {
+				fields[header[i]] = value
+			}
+		}
+		rows = append(rows, rawRow{line: line, fields: fields})
+	}
+	return rows, nil
+}
+
+func readJSON(r io.Reader) ([]rawRow, error) {
+	var objects []map[string]interface{}
+	if err := json.NewDecoder(r).Decode(&objects); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	rows := make([]rawRow, 0, len(objects))
+	for i, obj := range objects {
+		rows = append(rows, rawRow{line: i + 1, fields: stringFields(obj)})
+	}
+	return rows, nil
+}
+
+func readJSONL(r io.Reader) ([]rawRow, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var rows []rawRow
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var obj map[string]interface{}
+		if err := json.Unmarshal([]byte(text), &obj); err != nil {
+			return nil, fmt.Errorf("line %d: invalid JSON: %w", line, err)
+		}
+		rows = append(rows, rawRow{line: line, fields: stringFields(obj)})
+	}
+	return rows, scanner.Err()
+}
+
+// JSON 값을 CSV와 같은 문자열 필드로 변환
+func stringFields(obj map[string]interface{}) map[string]string {
+	fields := make(map[string]string, len(obj))
+	for key, value := range obj {
+		var s string
+		switch v := value.(type) {
+		case nil:
+			s = ""
+		case string:
+			s = v
+		case float64:
+			s = strconv.FormatFloat(v, 'f', -1, 64)
+		case []interface{}:
+			// 태그 배열은 CSV와 같은 쉼표 구분 문자열로
+			parts := make([]string, len(v))
+			for i, item := range v {
+				parts[i] = fmt.Sprint(item)
+			}
+			s = strings.Join(parts, ",")
+		default:
+			s = fmt.Sprint(v)
+		}
+		fields[normalizeColumn(key)] = s
+	}
+	return fields
+}
+
+// 필드 검증 후 MediaEntry 생성 (id 컬럼은 무시하고 새 ID 부여)
+func (rr rawRow) toEntry(opts Options) (models.MediaEntry, *utils.AppError) {
+	var entry models.MediaEntry
+	field := func(name string) string {
+		return strings.TrimSpace(rr.fields[name])
+	}
+
+	entry.Title = field("title")
+	if entry.Title == "" {
+		return entry, rowError(rr.line, "title is required")
+	}
+
+	if field("type") == "" {
+		if _, ok := rr.fields["letterboxd_uri"]; ok {
+			return entry, rowError(rr.line, "type is required (this looks like a Letterboxd export; use --format letterboxd)")
+		}
+		return entry, rowError(rr.line, "type is required (e.g. movie or drama)")
+	}
+	mediaType, err := models.ParseMediaType(field("type"))
+	if err != nil {
+		return entry, rowError(rr.line, err.Error())
+	}
+	entry.Type = mediaType
+
+	if ratingStr := field("rating"); ratingStr != "" {
+		// 좋아요/싫어요 척도는 up/down도 허용
+		value, err := strconv.ParseFloat(ratingStr, 64)
+		if err != nil && opts.Scale == models.ScaleThumbs {
+			entry.Rating, err = opts.Scale.Parse(ratingStr)
+		} else if err == nil {
+			entry.Rating, err = opts.Scale.Normalize(value)
+		} else {
+			err = fmt.Errorf("invalid rating %q", ratingStr)
+		}
+		if err != nil {
+			return entry, rowError(rr.line, err.Error())
+		}
+	}
+
+	entry.Comment = rr.fields["comment"]
+	entry.Review = strings.TrimSpace(rr.fields["review"])
+
+	if entry.DateWatched, err = parseImportTime(field("date_watched")); err != nil {
+		return entry, rowError(rr.line, fmt.Sprintf("invalid date_watched %q", field("date_watched")))
+	}
+	if entry.CreatedAt, err = parseImportTime(field("created_at")); err != nil {
+		return entry, rowError(rr.line, fmt.Sprintf("invalid created_at %q", field("created_at")))
+	}
+
+	entry.Tags = utils.ParseTags([]string{rr.fields["tags"]})
+	if statusStr := field("status"); statusStr != "" {
+		if entry.Status, err = models.ParseStatus(statusStr); err != nil {
+			return entry, rowError(rr.line, err.Error())
+		}
+	}
+
+	progress := []struct {
+		name  string
+		value *int
+	}{{"season", &entry.Season}, {"episodes_watched", &entry.EpisodesWatched}, {"total_episodes", &entry.TotalEpisodes}}
+	for _, column := range progress {
+		value := field(column.name)
+		if value == "" {
+			continue
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return entry, rowError(rr.line, fmt.Sprintf("invalid %s %q", column.name, value))
+		}
+		if n > 0 && !entry.Type.Info().Episodic {
+			return entry, rowError(rr.line, fmt.Sprintf("%s entries have no episodes to track", entry.Type.Label()))
+		}
+		*column.value = n
+	}
+	if entry.TotalEpisodes > 0 && entry.EpisodesWatched > entry.TotalEpisodes {
+		return entry, rowError(rr.line, fmt.Sprintf("%d episodes watched but the season only has %d",
+			entry.EpisodesWatched, entry.TotalEpisodes))
+	}
+
+	return entry, nil
+}
+
+func rowError(line int, message string) *utils.AppError {
+	return utils.ValidationError(fmt.Sprintf("row %d: %s", line, message), nil)
+}
+
+// 빈 값은 0 시간 (저장 시 현재 시각으로 대체)
+func parseImportTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range importTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse time: %s", s)
+}
@@ -0,0 +1,291 @@
+package transfer
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+// testdata/sample.*에 들어 있는 항목 (평점은 5점 척도를 정규화한 값)
+var sampleEntries = []models.MediaEntry{
+	{
+		Title:       "Past Lives",
+		Type:        models.Movie,
+		Rating:      0.9,
+		Comment:     "Quietly devastating",
+		Tags:        []string{"romance"},
+		Status:      models.StatusCompleted,
+		DateWatched: time.Date(2023, 6, 2, 20, 0, 0, 0, time.UTC),
+		CreatedAt:   time.Date(2023, 6, 3, 9, 0, 0, 0, time.UTC),
+	},
+	{
+		Title:       "Dune: Part Two",
+		Type:        models.Movie,
+		Rating:      0.8,
+		Comment:     "Loud, in a good way",
+		Review:      "The sound design alone\nis worth the ticket.",
+		Tags:        []string{"imax", "sci-fi"},
+		Status:      models.StatusCompleted,
+		DateWatched: time.Date(2024, 3, 1, 21, 30, 0, 0, time.UTC),
+		CreatedAt:   time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC),
+	},
+	{
+		Title:           "Frieren",
+		Type:            models.Anime,
+		Rating:          1,
+		Status:          models.StatusWatching,
+		Season:          1,
+		EpisodesWatched: 12,
+		TotalEpisodes:   28,
+		DateWatched:     time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+		CreatedAt:       time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC),
+	},
+}
+
+var fiveStar = Options{Scale: models.ScaleFiveStar}
+
+func readFixture(t *testing.T, name string, format Format, opts Options) []Row {
+	t.Helper()
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	rows, err := ReadRows(f, format, opts)
+	if err != nil {
+		t.Fatalf("ReadRows(%s): %v", name, err)
+	}
+	return rows
+}
+
+// 오류 없는 행의 항목만 모음
+func validEntries(t *testing.T, rows []Row) []models.MediaEntry {
+	t.Helper()
+	entries := make([]models.MediaEntry, 0, len(rows))
+	for _, row := range rows {
+		if row.Err != nil {
+			t.Fatalf("row %d: unexpected error: %v", row.Line, row.Err)
+		}
+		entries = append(entries, row.Entry)
+	}
+	return entries
+}
+
+func assertEntries(t *testing.T, got, want []models.MediaEntry) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(got[i], want[i]) {
+			t.Errorf("entry %d:\n got %+v\nwant %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReadFixtures(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		lines  []int
+	}{
+		{"sample.csv", FormatCSV, []int{2, 3, 5}}, // 감상문의 줄바꿈 때문에 3번째 항목은 5번째 줄
+		{"sample.json", FormatJSON, []int{1, 2, 3}},
+		{"sample.jsonl", FormatJSONL, []int{1, 2, 4}}, // 빈 줄은 건너뛰고 줄 번호는 유지
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, ok := FormatFromPath(tt.name)
+			if !ok || format != tt.format {
+				t.Fatalf("FormatFromPath(%q) = %q, %v", tt.name, format, ok)
+			}
+
+			rows := readFixture(t, tt.name, tt.format, fiveStar)
+			assertEntries(t, validEntries(t, rows), sampleEntries)
+			for i, row := range rows {
+				if row.Line != tt.lines[i] {
+					t.Errorf("row %d: line = %d, want %d", i, row.Line, tt.lines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	scales := []models.RatingScale{models.ScaleFiveStar, models.ScaleTenPoint, models.ScaleHundredPoint}
+	for _, format := range []Format{FormatCSV, FormatJSON, FormatJSONL} {
+		for _, scale := range scales {
+			t.Run(string(format)+"/"+string(scale), func(t *testing.T) {
+				opts := Options{Scale: scale}
+				var buf bytes.Buffer
+				w, err := NewWriter(&buf, format, opts)
+				if err != nil {
+					t.Fatalf("NewWriter: %v", err)
+				}
+				for i, entry := range sampleEntries {
+					entry.ID = i + 1
+					if err := w.Write(entry); err != nil {
+						t.Fatalf("Write: %v", err)
+					}
+				}
+				if err := w.Close(); err != nil {
+					t.Fatalf("Close: %v", err)
+				}
+
+				rows, err := ReadRows(&buf, format, opts)
+				if err != nil {
+					t.Fatalf("ReadRows: %v\n%s", err, buf.String())
+				}
+				// 가져올 때 id는 무시하므로 항목은 원래대로
+				got := validEntries(t, rows)
+				assertEntries(t, got, sampleEntries)
+
+				// 태그, 상태, 회차 정보도 빠지지 않음
+				if frieren := got[2]; frieren.Status != models.StatusWatching || frieren.Progress() != "S1 · 12/28" {
+					t.Errorf("progress lost: status %q, progress %q", frieren.Status, frieren.Progress())
+				}
+				if tags := got[1].Tags; !reflect.DeepEqual(tags, []string{"imax", "sci-fi"}) {
+					t.Errorf("tags = %v, want [imax sci-fi]", tags)
+				}
+			})
+		}
+	}
+}
+
+func TestExportMatchesFixtures(t *testing.T) {
+	for _, name := range []string{"sample.csv", "sample.json", "sample.jsonl"} {
+		t.Run(name, func(t *testing.T) {
+			format, _ := FormatFromPath(name)
+			var buf bytes.Buffer
+			w, err := NewWriter(&buf, format, fiveStar)
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			for i, entry := range sampleEntries {
+				entry.ID = i + 1
+				if err := w.Write(entry); err != nil {
+					t.Fatalf("Write: %v", err)
+				}
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close: %v", err)
+			}
+
+			want, err := os.ReadFile(filepath.Join("testdata", name))
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 픽스처의 JSONL에는 빈 줄이 하나 있음 (가져오기에서 건너뛰는지 확인용)
+			got := buf.String()
+			if format == FormatJSONL {
+				want = bytes.ReplaceAll(want, []byte("\n\n"), []byte("\n"))
+			}
+			if got != string(want) {
+				t.Errorf("export differs from testdata/%s:\n%s", name, got)
+			}
+		})
+	}
+}
+
+func TestRowErrors(t *testing.T) {
+	rows := readFixture(t, "invalid.csv", FormatCSV, fiveStar)
+
+	want := []struct {
+		line    int
+		message string // 비어 있으면 유효한 행
+	}{
+		{2, ""},
+		{3, "row 3: title is required"},
+		{4, "row 4: type is required (e.g. movie or drama)"},
+		{5, `row 5: unknown media type "Movie!"`},
+		{6, "row 6: rating must be between 0 and 5"},
+		{7, `row 7: invalid rating "great"`},
+		{8, `row 8: invalid date_watched "last summer"`},
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, w := range want {
+		row := rows[i]
+		if row.Line != w.line {
+			t.Errorf("row %d: line = %d, want %d", i, row.Line, w.line)
+		}
+		switch {
+		case w.message == "" && row.Err != nil:
+			t.Errorf("line %d: unexpected error %v", w.line, row.Err)
+		case w.message != "" && row.Err == nil:
+			t.Errorf("line %d: expected error %q, got entry %+v", w.line, w.message, row.Entry)
+		case w.message != "" && !strings.HasPrefix(row.Err.Message, w.message):
+			t.Errorf("line %d: error = %q, want prefix %q", w.line, row.Err.Message, w.message)
+		}
+	}
+}
+
+func TestProgressRowErrors(t *testing.T) {
+	input := strings.Join([]string{
+		"title,type,status,season,episodes_watched,total_episodes",
+		"Frieren,anime,paused,1,12,28",
+		"Frieren,anime,bored,,,",
+		"Dune,movie,completed,0,3,0",
+		"Frieren,anime,watching,1,30,28",
+		"Frieren,anime,watching,-1,,",
+	}, "\n")
+	rows, err := ReadRows(strings.NewReader(input), FormatCSV, fiveStar)
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+
+	if rows[0].Err != nil || rows[0].Entry.Status != models.StatusOnHold || rows[0].Entry.TotalEpisodes != 28 {
+		t.Errorf("row 2 = %+v, %v", rows[0].Entry, rows[0].Err)
+	}
+	want := []string{
+		`row 3: unknown status "bored"`,
+		"row 4: Movie entries have no episodes to track",
+		"row 5: 30 episodes watched but the season only has 28",
+		`row 6: invalid season "-1"`,
+	}
+	for i, message := range want {
+		row := rows[i+1]
+		if row.Err == nil || !strings.HasPrefix(row.Err.Message, message) {
+			t.Errorf("row %d: error = %v, want %q", row.Line, row.Err, message)
+		}
+	}
+}
+
+func TestReadRowsThumbsScale(t *testing.T) {
+	input := "title,type,rating\nDune,movie,up\nCats,movie,-1\nTenet,movie,\n"
+	rows, err := ReadRows(strings.NewReader(input), FormatCSV, Options{Scale: models.ScaleThumbs})
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+	entries := validEntries(t, rows)
+	ratings := []float64{entries[0].Rating, entries[1].Rating, entries[2].Rating}
+	if want := []float64{models.ThumbsUp, models.ThumbsDown, 0}; !reflect.DeepEqual(ratings, want) {
+		t.Errorf("ratings = %v, want %v", ratings, want)
+	}
+}
+
+func TestReadRowsInvalidFiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		format Format
+		input  string
+	}{
+		{"json not an array", FormatJSON, `{"title": "Dune"}`},
+		{"broken jsonl line", FormatJSONL, "{\"title\": \"Dune\"}\n{oops\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ReadRows(strings.NewReader(tt.input), tt.format, fiveStar); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// Letterboxd 별점 범위 (0.5 ~ 5, 0.5 단위)
+const (
+	letterboxdMaxStars = 5.0
+	letterboxdStep     = 0.5
+)
+
+// LetterboxdColumns diary.csv 컬럼 순서 (Review는 reviews.csv와 같은 이름)
+var LetterboxdColumns = []string{
+	"Date", "Name", "Year", "Letterboxd URI", "Rating", "Rewatch", "Tags", "Watched Date", "Review",
+}
+
+const letterboxdDateLayout = "2006-01-02"
+
+// StarsToRating Letterboxd 별점을 정규화 평점으로 변환
+func StarsToRating(stars float64) float64 {
+	return stars / letterboxdMaxStars
+}
+
+// RatingToStars 정규화 평점을 Letterboxd 별점(0.5 단위)으로 변환, 평점이 0이면 0(미평가)
+func RatingToStars(rating float64) float64 {
+	if rating <= 0 {
+		return 0
+	}
+	stars := math.Round(rating*letterboxdMaxStars/letterboxdStep) * letterboxdStep
+	return math.Min(math.Max(stars, letterboxdStep), letterboxdMaxStars)
+}
+
+// IsLetterboxdCSV r의 첫 줄이 Letterboxd 내보내기의 머리인지 (r에서 읽은 내용은 소비하지 않음)
+// Letterboxd URI 컬럼이 있거나, Name 컬럼은 있는데 type 컬럼이 없으면 Letterboxd로 봄
+func IsLetterboxdCSV(r *bufio.Reader) bool {
+	head, _ := r.Peek(r.Size())
+	line, _, _ := bytes.Cut(head, []byte("\n"))
+	header, err := csv.NewReader(bytes.NewReader(line)).Read()
+	if err != nil {
+		return false
+	}
+
+	columns := make(map[string]bool, len(header))
+	for _, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = true
+	}
+	return columns["letterboxd uri"] || (columns["name"] && !columns["type"])
+}
+
+// diary.csv / ratings.csv를 읽어 영화 항목으로 변환
+func readLetterboxd(r io.Reader) ([]Row, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := index["name"]; !ok {
+		return nil, fmt.Errorf("not a Letterboxd export: missing Name column")
+	}
+
+	var rows []Row
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		field := func(name string) string {
+			if i, ok := index[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		entry, appErr := letterboxdEntry(line, field)
+		rows = append(rows, Row{Line: line, Entry: entry, Err: appErr})
+	}
+	return rows, nil
+}
+
+func letterboxdEntry(line int, field func(string) string) (models.MediaEntry, *utils.AppError) {
+	entry := models.MediaEntry{
+		Title:  field("name"),
+		Type:   models.Movie,
+		Review: field("review"),
+	}
+	if entry.Title == "" {
+		return entry, rowError(line, "Name is required")
+	}
+
+	if starsStr := field("rating"); starsStr != "" {
+		stars, err := strconv.ParseFloat(starsStr, 64)
+		if err != nil || stars < 0 || stars > letterboxdMaxStars {
+			return entry, rowError(line, fmt.Sprintf("invalid Letterboxd rating %q", starsStr))
+		}
+		entry.Rating = StarsToRating(stars)
+	}
+
+	// diary.csv는 Watched Date, ratings.csv는 Date(평가일)만 있음
+	dateStr := field("watched date")
+	if dateStr == "" {
+		dateStr = field("date")
+	}
+	if dateStr != "" {
+		watched, err := time.Parse(letterboxdDateLayout, dateStr)
+		if err != nil {
+			return entry, rowError(line, fmt.Sprintf("invalid date %q", dateStr))
+		}
+		entry.DateWatched = watched
+	}
+
+	if loggedStr := field("date"); loggedStr != "" {
+		if logged, err := time.Parse(letterboxdDateLayout, loggedStr); err == nil {
+			entry.CreatedAt = logged
+		}
+	}
+
+	return entry, nil
+}
+
+// 같은 diary.csv 레이아웃으로 기록 (드라마는 Letterboxd에 없으므로 건너뜀)
+type letterboxdWriter struct {
+	w *csv.Writer
+}
+
+func newLetterboxdWriter(w io.Writer) (*letterboxdWriter, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(LetterboxdColumns); err != nil {
+		return nil, err
+	}
+	return &letterboxdWriter{w: cw}, nil
+}
+
+func (l *letterboxdWriter) Write(entry models.MediaEntry) error {
+	if entry.Type != models.Movie {
+		return nil
+	}
+
+	rating := ""
+	if stars := RatingToStars(entry.Rating); stars > 0 {
+		rating = strconv.FormatFloat(stars, 'f', -1, 64)
+	}
+
+	logged := entry.CreatedAt
+	if logged.IsZero() {
+		logged = entry.DateWatched
+	}
+
+	return l.w.Write([]string{
+		logged.Format(letterboxdDateLayout),
+		entry.Title,
+		"", // Year: morama는 개봉 연도를 저장하지 않음
+		"", // Letterboxd URI
+		rating,
+		"", // Rewatch
+		"", // Tags
+		entry.DateWatched.Format(letterboxdDateLayout),
+		letterboxdReview(entry),
+	})
+}
+
+// Letterboxd 리뷰는 긴 글이므로 감상문을 쓰고, 없으면 한줄평
+func letterboxdReview(entry models.MediaEntry) string {
+	if entry.Review != "" {
+		return entry.Review
+	}
+	return entry.Comment
+}
+
+func (l *letterboxdWriter) Close() error {
+	l.w.Flush()
+	return l.w.Error()
+}
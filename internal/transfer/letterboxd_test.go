@@ -0,0 +1,150 @@
+package transfer
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+)
+
+func TestReadLetterboxdDiary(t *testing.T) {
+	// Letterboxd는 항상 별 0.5~5 (설정된 척도와 무관)
+	rows := readFixture(t, "diary.csv", FormatLetterboxd, Options{Scale: models.ScaleHundredPoint})
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	want := []models.MediaEntry{
+		{Title: "Past Lives", Type: models.Movie, Rating: 0.9, DateWatched: date(2023, 6, 2), CreatedAt: date(2023, 6, 3)},
+		{Title: "Dune: Part Two", Type: models.Movie, Rating: 0.8, Review: "The sound design alone, honestly.",
+			DateWatched: date(2024, 3, 1), CreatedAt: date(2024, 3, 2)},
+		{Title: "Dune: Part Two", Type: models.Movie, DateWatched: date(2024, 3, 9), CreatedAt: date(2024, 3, 10)},
+	}
+	assertEntries(t, validEntries(t, rows), want)
+}
+
+func TestLetterboxdRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, FormatLetterboxd, fiveStar)
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	for _, entry := range sampleEntries {
+		if err := w.Write(entry); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	rows, err := ReadRows(&buf, FormatLetterboxd, fiveStar)
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+	got := validEntries(t, rows)
+
+	// 영화만 기록되고, 날짜는 일 단위, 감상문이 없으면 한줄평이 리뷰가 됨
+	truncate := func(t time.Time) time.Time { return t.Truncate(24 * time.Hour) }
+	want := []models.MediaEntry{
+		{Title: "Past Lives", Type: models.Movie, Rating: 0.9, Review: "Quietly devastating",
+			DateWatched: truncate(sampleEntries[0].DateWatched), CreatedAt: truncate(sampleEntries[0].CreatedAt)},
+		{Title: "Dune: Part Two", Type: models.Movie, Rating: 0.8, Review: sampleEntries[1].Review,
+			DateWatched: truncate(sampleEntries[1].DateWatched), CreatedAt: truncate(sampleEntries[1].CreatedAt)},
+	}
+	assertEntries(t, got, want)
+}
+
+func TestLetterboxdRowErrors(t *testing.T) {
+	input := strings.Join([]string{
+		"Date,Name,Rating,Watched Date",
+		"2024-03-02,,4,2024-03-01",
+		"2024-03-02,Dune,6,2024-03-01",
+		"2024-03-02,Dune,four,2024-03-01",
+		"2024-03-02,Dune,4,03/01/2024",
+	}, "\n")
+	rows, err := ReadRows(strings.NewReader(input), FormatLetterboxd, fiveStar)
+	if err != nil {
+		t.Fatalf("ReadRows: %v", err)
+	}
+
+	want := []string{
+		"row 2: Name is required",
+		`row 3: invalid Letterboxd rating "6"`,
+		`row 4: invalid Letterboxd rating "four"`,
+		`row 5: invalid date "03/01/2024"`,
+	}
+	if len(rows) != len(want) {
+		t.Fatalf("got %d rows, want %d", len(rows), len(want))
+	}
+	for i, message := range want {
+		if rows[i].Err == nil || rows[i].Err.Message != message {
+			t.Errorf("row %d: error = %v, want %q", i, rows[i].Err, message)
+		}
+	}
+}
+
+func TestReadLetterboxdWithoutNameColumn(t *testing.T) {
+	_, err := ReadRows(strings.NewReader("Date,Title\n2024-03-01,Dune\n"), FormatLetterboxd, fiveStar)
+	if err == nil {
+		t.Error("expected an error for a CSV without a Name column")
+	}
+}
+
+func TestRatingToStars(t *testing.T) {
+	tests := []struct {
+		rating, stars float64
+	}{
+		{0, 0},
+		{0.05, 0.5}, // 가장 낮은 별점은 0.5
+		{0.33, 1.5},
+		{0.87, 4.5},
+		{1, 5},
+	}
+	for _, tt := range tests {
+		if got := RatingToStars(tt.rating); got != tt.stars {
+			t.Errorf("RatingToStars(%v) = %v, want %v", tt.rating, got, tt.stars)
+		}
+		if tt.stars > 0 && RatingToStars(StarsToRating(tt.stars)) != tt.stars {
+			t.Errorf("StarsToRating(%v) does not round-trip", tt.stars)
+		}
+	}
+}
+
+func TestIsLetterboxdCSV(t *testing.T) {
+	tests := []struct {
+		name, head string
+		want       bool
+	}{
+		{"diary", "Date,Name,Year,Letterboxd URI,Rating,Rewatch,Tags,Watched Date\n2024-03-02,Dune", true},
+		{"ratings with BOM", "\ufeffDate,Name,Year,Letterboxd URI,Rating\n", true},
+		{"name without type", "Name,Rating\nDune,4\n", true},
+		{"morama export", strings.Join(Columns, ",") + "\n1,Dune,movie", false},
+		{"name alias with type", "name,type,rating\nDune,movie,4\n", false},
+		{"empty", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bufio.NewReader(strings.NewReader(tt.head))
+			if got := IsLetterboxdCSV(r); got != tt.want {
+				t.Errorf("IsLetterboxdCSV = %v, want %v", got, tt.want)
+			}
+			// 머리를 확인해도 입력은 그대로
+			if rest, _ := io.ReadAll(r); string(rest) != tt.head {
+				t.Errorf("reader consumed input: %q", rest)
+			}
+		})
+	}
+}
+
+func TestDiaryAsGenericCSVSuggestsLetterboxd(t *testing.T) {
+	rows := readFixture(t, "diary.csv", FormatCSV, fiveStar)
+	for _, row := range rows {
+		if row.Err == nil || !strings.Contains(row.Err.Message, "use --format letterboxd") {
+			t.Errorf("row %d: error = %v, want a --format letterboxd hint", row.Line, row.Err)
+		}
+	}
+}
@@ -0,0 +1,454 @@
+package tui
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+// Store tui가 쓰는 저장소 기능 (*storage.Storage가 구현하므로 CLI와 같은 동작)
+type Store interface {
+	FindEntries(q storage.Query) ([]models.MediaEntry, error)
+	AddEntry(entry models.MediaEntry) (int, error)
+	UpdateEntry(id int, entry models.MediaEntry) error
+	DeleteByIDs(ids []int) ([]int, error)
+}
+
+// Options 화면 설정
+type Options struct {
+	Query      storage.Query      // 불러올 항목 조건 (타입 등)
+	Scale      models.RatingScale // 평점 입력/표시 척도
+	DateFormat string             // 표의 시청일 형식
+}
+
+type mode int
+
+const (
+	modeBrowse  mode = iota // 표 탐색
+	modeFilter              // 필터 입력
+	modeForm                // 추가/수정/평점 입력
+	modeConfirm             // 삭제 확인
+	modeHelp                // 키 도움말
+)
+
+// App 전체 화면 브라우저 상태
+// 키 입력은 HandleKey, 화면은 View로 분리되어 있어 터미널 없이도 동작을 확인할 수 있음
+type App struct {
+	store Store
+	opts  Options
+
+	entries []models.MediaEntry // 불러온 전체 항목 (시청일 최신순)
+	visible []models.MediaEntry // 필터가 적용된 항목
+	filter  string
+
+	cursor   int // visible에서 선택된 위치
+	offset   int // 표 맨 위에 보이는 위치
+	pageSize int // 마지막으로 그린 표의 행 수 (PgUp/PgDn 이동량)
+
+	mode    mode
+	form    *form
+	message string // 상태 줄 메시지 (다음 키 입력 시 지움)
+	quit    bool
+}
+
+// New 저장소와 화면 설정으로 App 생성 (항목은 Load 또는 Run에서 불러옴)
+func New(store Store, opts Options) *App {
+	if opts.DateFormat == "" {
+		opts.DateFormat = "2006-01-02"
+	}
+	return &App{store: store, opts: opts, pageSize: 10}
+}
+
+// Load 저장소에서 항목을 다시 불러옴 (선택된 항목은 가능하면 유지)
+func (a *App) Load() error {
+	selectedID := 0
+	if entry, ok := a.Selected(); ok {
+		selectedID = entry.ID
+	}
+
+	entries, err := a.store.FindEntries(a.opts.Query)
+	if err != nil {
+		return err
+	}
+	a.entries = entries
+	a.applyFilter(selectedID)
+	return nil
+}
+
+// Run 입력이 끝나거나 종료 키를 누를 때까지 화면을 그리고 키를 처리
+func (a *App) Run(t Terminal) error {
+	if err := a.Load(); err != nil {
+		return err
+	}
+
+	keys := newKeyReader(t)
+	for !a.quit {
+		width, height, err := t.Size()
+		if err != nil || width <= 0 || height <= 0 {
+			width, height = 80, 24
+		}
+		if _, err := io.WriteString(t, "\x1b[H"+a.View(width, height)+"\x1b[J"); err != nil {
+			return err
+		}
+
+		key, err := keys.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		a.HandleKey(key)
+	}
+	return nil
+}
+
+// Done 종료 키를 눌렀는지
+func (a *App) Done() bool {
+	return a.quit
+}
+
+// Selected 현재 선택된 항목
+func (a *App) Selected() (models.MediaEntry, bool) {
+	if a.cursor < 0 || a.cursor >= len(a.visible) {
+		return models.MediaEntry{}, false
+	}
+	return a.visible[a.cursor], true
+}
+
+// Visible 필터가 적용된 항목 (표 순서)
+func (a *App) Visible() []models.MediaEntry {
+	return a.visible
+}
+
+// Message 상태 줄 메시지
+func (a *App) Message() string {
+	return a.message
+}
+
+// HandleKey 키 하나 처리
+func (a *App) HandleKey(key Key) {
+	if key.Kind == KeyCtrlC {
+		a.quit = true
+		return
+	}
+
+	switch a.mode {
+	case modeFilter:
+		a.handleFilterKey(key)
+	case modeForm:
+		a.handleFormKey(key)
+	case modeConfirm:
+		a.handleConfirmKey(key)
+	case modeHelp:
+		a.mode = modeBrowse
+	default:
+		a.message = ""
+		a.handleBrowseKey(key)
+	}
+}
+
+func (a *App) handleBrowseKey(key Key) {
+	switch key.Kind {
+	case KeyUp:
+		a.move(-1)
+	case KeyDown:
+		a.move(1)
+	case KeyPgUp:
+		a.move(-a.pageSize)
+	case KeyPgDown:
+		a.move(a.pageSize)
+	case KeyHome:
+		a.move(-len(a.visible))
+	case KeyEnd:
+		a.move(len(a.visible))
+	case KeyEnter:
+		a.startEdit()
+	case KeyDelete:
+		a.startDelete()
+	case KeyEsc:
+		if a.filter != "" {
+			a.setFilter("")
+		}
+	case KeyRune:
+		switch key.Rune {
+		case 'k':
+			a.move(-1)
+		case 'j':
+			a.move(1)
+		case 'g':
+			a.move(-len(a.visible))
+		case 'G':
+			a.move(len(a.visible))
+		case '/':
+			a.mode = modeFilter
+		case 'a':
+			a.startAdd()
+		case 'e':
+			a.startEdit()
+		case 'r':
+			a.startRate()
+		case '+', '=':
+			a.stepRating(1)
+		case '-':
+			a.stepRating(-1)
+		case 'd':
+			a.startDelete()
+		case '?':
+			a.mode = modeHelp
+		case 'q':
+			a.quit = true
+		}
+	}
+}
+
+func (a *App) move(delta int) {
+	a.cursor += delta
+	if a.cursor >= len(a.visible) {
+		a.cursor = len(a.visible) - 1
+	}
+	if a.cursor < 0 {
+		a.cursor = 0
+	}
+}
+
+func (a *App) handleFilterKey(key Key) {
+	switch key.Kind {
+	case KeyEnter:
+		a.mode = modeBrowse
+	case KeyEsc:
+		a.mode = modeBrowse
+		a.setFilter("")
+	case KeyBackspace:
+		a.setFilter(dropLastRune(a.filter))
+	case KeyUp, KeyDown:
+		a.mode = modeBrowse
+		a.handleBrowseKey(key)
+	case KeyRune:
+		a.setFilter(a.filter + string(key.Rune))
+	}
+}
+
+func (a *App) setFilter(filter string) {
+	selectedID := 0
+	if entry, ok := a.Selected(); ok {
+		selectedID = entry.ID
+	}
+	a.filter = filter
+	a.applyFilter(selectedID)
+}
+
+// 필터의 모든 단어가 제목, 한줄평, 타입, 태그 중 하나에 포함된 항목만 남김 (대소문자 무시)
+// selectedID 항목이 남아 있으면 그 항목을 계속 선택
+func (a *App) applyFilter(selectedID int) {
+	terms := strings.Fields(strings.ToLower(a.filter))
+	a.visible = a.visible[:0:0]
+	for _, entry := range a.entries {
+		if matchesFilter(entry, terms) {
+			a.visible = append(a.visible, entry)
+		}
+	}
+
+	a.cursor = 0
+	for i, entry := range a.visible {
+		if entry.ID == selectedID {
+			a.cursor = i
+			break
+		}
+	}
+}
+
+func matchesFilter(entry models.MediaEntry, terms []string) bool {
+	text := strings.ToLower(strings.Join(append([]string{entry.Title, entry.Comment, string(entry.Type)}, entry.Tags...), "\n"))
+	for _, term := range terms {
+		if !strings.Contains(text, term) {
+			return false
+		}
+	}
+	return true
+}
+
+// 평점을 척도 단위만큼 올리거나 내림 (좋아요/싫어요는 +가 좋아요, -가 싫어요)
+func (a *App) stepRating(direction int) {
+	entry, ok := a.Selected()
+	if !ok {
+		return
+	}
+
+	scale := a.opts.Scale
+	var rating float64
+	if scale == models.ScaleThumbs {
+		rating = models.ThumbsUp
+		if direction < 0 {
+			rating = models.ThumbsDown
+		}
+	} else {
+		value := scale.Value(entry.Rating) + float64(direction)*scale.Step()
+		value = max(scale.Step(), min(value, scale.Max()))
+		rating, _ = scale.Normalize(value)
+	}
+	if rating == entry.Rating {
+		return
+	}
+
+	entry.Rating = rating
+	a.update(entry, fmt.Sprintf("⭐ Rated %s %s", entry.Title, scale.FormatOutOf(rating)))
+}
+
+func (a *App) startAdd() {
+	scale := a.opts.Scale
+	a.openForm(&form{
+		title: "Add entry",
+		fields: []formField{
+			{label: "Title", validate: requireValue("title")},
+			{label: "Type", value: string(models.Movie), validate: func(s string) error {
+				_, err := models.ParseMediaType(s)
+				return err
+			}},
+			{label: fmt.Sprintf("Rating (%s)", scale.Hint()), validate: func(s string) error {
+				_, err := scale.Parse(s)
+				return err
+			}},
+			{label: "Comment"},
+		},
+		submit: func(values []string) error {
+			mediaType, _ := models.ParseMediaType(values[1])
+			rating, _ := scale.Parse(values[2])
+			entry := models.MediaEntry{
+				Title:   strings.TrimSpace(values[0]),
+				Type:    mediaType,
+				Rating:  rating,
+				Comment: values[3],
+			}
+			id, err := a.store.AddEntry(entry)
+			if err != nil {
+				return err
+			}
+
+			utils.LogUserAction("tui_added", fmt.Sprintf("id: %d, title: %s, type: %s", id, entry.Title, entry.Type))
+			if err := a.Load(); err != nil {
+				return err
+			}
+			a.selectID(id)
+			a.message = fmt.Sprintf("✅ Added %s", entry.Title)
+			return nil
+		},
+	})
+}
+
+func (a *App) startEdit() {
+	entry, ok := a.Selected()
+	if !ok {
+		return
+	}
+
+	scale := a.opts.Scale
+	a.openForm(&form{
+		title: fmt.Sprintf("Edit [%d]", entry.ID),
+		fields: []formField{
+			{label: "Title", value: entry.Title, validate: requireValue("title")},
+			{label: fmt.Sprintf("Rating (%s)", scale.Hint()), value: scale.Input(entry.Rating), validate: func(s string) error {
+				_, err := scale.Parse(s)
+				return err
+			}},
+			{label: "Comment", value: entry.Comment},
+		},
+		submit: func(values []string) error {
+			entry.Title = strings.TrimSpace(values[0])
+			entry.Rating, _ = scale.Parse(values[1])
+			entry.Comment = values[2]
+			return a.update(entry, fmt.Sprintf("✅ Updated %s", entry.Title))
+		},
+	})
+}
+
+func (a *App) startRate() {
+	entry, ok := a.Selected()
+	if !ok {
+		return
+	}
+
+	scale := a.opts.Scale
+	a.openForm(&form{
+		title: fmt.Sprintf("Rate %s", entry.Title),
+		fields: []formField{
+			{label: fmt.Sprintf("Rating (%s)", scale.Hint()), value: scale.Input(entry.Rating), validate: func(s string) error {
+				_, err := scale.Parse(s)
+				return err
+			}},
+		},
+		submit: func(values []string) error {
+			entry.Rating, _ = scale.Parse(values[0])
+			return a.update(entry, fmt.Sprintf("⭐ Rated %s %s", entry.Title, scale.FormatOutOf(entry.Rating)))
+		},
+	})
+}
+
+// 항목 저장 후 다시 불러옴 (실패하면 상태 줄에 표시)
+func (a *App) update(entry models.MediaEntry, message string) error {
+	if err := a.store.UpdateEntry(entry.ID, entry); err != nil {
+		a.message = fmt.Sprintf("❌ Failed to update entry: %v", err)
+		return err
+	}
+	utils.LogUserAction("tui_updated", fmt.Sprintf("id: %d, title: %s", entry.ID, entry.Title))
+
+	if err := a.Load(); err != nil {
+		a.message = fmt.Sprintf("❌ Failed to reload entries: %v", err)
+		return err
+	}
+	a.message = message
+	return nil
+}
+
+// ID가 id인 항목을 선택 (필터에 걸러졌으면 그대로)
+func (a *App) selectID(id int) {
+	for i, entry := range a.visible {
+		if entry.ID == id {
+			a.cursor = i
+			return
+		}
+	}
+}
+
+func (a *App) startDelete() {
+	if _, ok := a.Selected(); ok {
+		a.mode = modeConfirm
+	}
+}
+
+func (a *App) handleConfirmKey(key Key) {
+	a.mode = modeBrowse
+	entry, ok := a.Selected()
+	if !ok || key.Kind != KeyRune || (key.Rune != 'y' && key.Rune != 'Y') {
+		a.message = "Delete cancelled"
+		return
+	}
+
+	if _, err := a.store.DeleteByIDs([]int{entry.ID}); err != nil {
+		a.message = fmt.Sprintf("❌ Failed to delete entry: %v", err)
+		return
+	}
+	utils.LogUserAction("tui_deleted", fmt.Sprintf("id: %d, title: %s", entry.ID, entry.Title))
+
+	cursor := a.cursor
+	if err := a.Load(); err != nil {
+		a.message = fmt.Sprintf("❌ Failed to reload entries: %v", err)
+		return
+	}
+	// 삭제한 자리의 다음 항목을 선택
+	a.cursor = cursor
+	a.move(0)
+	a.message = fmt.Sprintf("🗑️ Moved [%d] %s to the trash (morama undo restores it)", entry.ID, entry.Title)
+}
+
+func dropLastRune(s string) string {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return s
+	}
+	return string(runes[:len(runes)-1])
+}
@@ -0,0 +1,286 @@
+package tui
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/kiku99/morama/internal/models"
+	"github.com/kiku99/morama/internal/storage"
+)
+
+// 입력 바이트를 미리 채운 가짜 터미널 (입력이 끝나면 Run이 끝남)
+type fakeTerminal struct {
+	in            *strings.Reader
+	out           bytes.Buffer
+	width, height int
+}
+
+func newFakeTerminal(input string) *fakeTerminal {
+	return &fakeTerminal{in: strings.NewReader(input), width: 100, height: 30}
+}
+
+func (t *fakeTerminal) Read(p []byte) (int, error)  { return t.in.Read(p) }
+func (t *fakeTerminal) Write(p []byte) (int, error) { return t.out.Write(p) }
+func (t *fakeTerminal) Size() (int, int, error)     { return t.width, t.height, nil }
+
+// 마지막으로 그린 화면
+func (t *fakeTerminal) lastFrame() string {
+	frames := strings.Split(t.out.String(), "\x1b[H")
+	return frames[len(frames)-1]
+}
+
+// 메모리에 항목을 두는 Store
+type memStore struct {
+	entries []models.MediaEntry
+	nextID  int
+	updates []models.MediaEntry // UpdateEntry로 받은 항목 (순서대로)
+}
+
+func newMemStore(entries ...models.MediaEntry) *memStore {
+	s := &memStore{nextID: 1}
+	for _, entry := range entries {
+		entry.ID = s.nextID
+		s.nextID++
+		s.entries = append(s.entries, entry)
+	}
+	return s
+}
+
+func (s *memStore) FindEntries(q storage.Query) ([]models.MediaEntry, error) {
+	var found []models.MediaEntry
+	for _, entry := range s.entries {
+		if q.Type == "" || entry.Type == q.Type {
+			found = append(found, entry)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].DateWatched.After(found[j].DateWatched) })
+	return found, nil
+}
+
+func (s *memStore) AddEntry(entry models.MediaEntry) (int, error) {
+	entry.ID = s.nextID
+	s.nextID++
+	s.entries = append(s.entries, entry)
+	return entry.ID, nil
+}
+
+func (s *memStore) UpdateEntry(id int, entry models.MediaEntry) error {
+	for i := range s.entries {
+		if s.entries[i].ID == id {
+			s.entries[i] = entry
+			s.updates = append(s.updates, entry)
+			return nil
+		}
+	}
+	return fmt.Errorf("%w with ID %d", storage.ErrNotFound, id)
+}
+
+func (s *memStore) DeleteByIDs(ids []int) ([]int, error) {
+	var deleted []int
+	for _, id := range ids {
+		for i, entry := range s.entries {
+			if entry.ID == id {
+				s.entries = append(s.entries[:i], s.entries[i+1:]...)
+				deleted = append(deleted, id)
+				break
+			}
+		}
+	}
+	return deleted, nil
+}
+
+func day(n int) time.Time {
+	return time.Date(2024, 3, n, 0, 0, 0, 0, time.UTC)
+}
+
+// 시청일 최신순: Frieren(3), Dune(2), Past Lives(1)
+func newTestApp(t *testing.T) (*App, *memStore) {
+	t.Helper()
+	store := newMemStore(
+		models.MediaEntry{Title: "Past Lives", Type: models.Movie, Rating: 0.9, Comment: "quiet", DateWatched: day(1)},
+		models.MediaEntry{Title: "Dune", Type: models.Movie, Rating: 0.8, Comment: "sand", Tags: []string{"sci-fi"}, DateWatched: day(2)},
+		models.MediaEntry{Title: "Frieren", Type: models.Anime, Rating: 1, Comment: "elves", DateWatched: day(3)},
+	)
+	app := New(store, Options{Scale: models.ScaleFiveStar})
+	if err := app.Load(); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return app, store
+}
+
+func press(app *App, input string) {
+	keys, _ := ParseKeys([]byte(input))
+	for _, key := range keys {
+		app.HandleKey(key)
+	}
+}
+
+func titles(entries []models.MediaEntry) []string {
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Title
+	}
+	return names
+}
+
+func assertSelected(t *testing.T, app *App, title string) {
+	t.Helper()
+	entry, ok := app.Selected()
+	if !ok || entry.Title != title {
+		t.Fatalf("selected = %q (ok %v), want %q", entry.Title, ok, title)
+	}
+}
+
+func TestMoveSelection(t *testing.T) {
+	app, _ := newTestApp(t)
+	assertSelected(t, app, "Frieren")
+
+	steps := []struct {
+		input string
+		want  string
+	}{
+		{"j", "Dune"},
+		{"\x1b[B", "Past Lives"},
+		{"j", "Past Lives"}, // 마지막에서 더 내려가지 않음
+		{"k", "Dune"},
+		{"g", "Frieren"},
+		{"\x1b[A", "Frieren"},
+		{"G", "Past Lives"},
+		{"\x1b[H", "Frieren"},
+	}
+	for _, step := range steps {
+		press(app, step.input)
+		assertSelected(t, app, step.want)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	app, _ := newTestApp(t)
+	press(app, "j") // Dune 선택
+
+	press(app, "/SCI")
+	if got := titles(app.Visible()); len(got) != 1 || got[0] != "Dune" {
+		t.Fatalf("visible after /SCI = %v, want [Dune] (tag match, case-insensitive)", got)
+	}
+	if frame := app.View(100, 30); !strings.Contains(frame, "1 of 3 entries · filter: SCI") {
+		t.Errorf("title bar does not show the filter:\n%s", frame)
+	}
+
+	// 지우고 다시 입력해도 선택은 유지
+	press(app, "\x7f\x7f\x7fmovie")
+	if got := titles(app.Visible()); len(got) != 2 {
+		t.Fatalf("visible after /movie = %v, want two movies", got)
+	}
+	assertSelected(t, app, "Dune")
+
+	press(app, "\r")
+	press(app, "j")
+	assertSelected(t, app, "Past Lives")
+
+	press(app, "/")
+	press(app, "nothing")
+	if len(app.Visible()) != 0 {
+		t.Fatalf("visible = %v, want none", titles(app.Visible()))
+	}
+	if frame := app.View(100, 30); !strings.Contains(frame, "No entries match the filter") {
+		t.Errorf("empty filter result not shown:\n%s", frame)
+	}
+
+	// Esc는 필터를 지움
+	press(app, "\x1b")
+	if got := len(app.Visible()); got != 3 {
+		t.Errorf("visible after Esc = %d, want 3", got)
+	}
+}
+
+func TestDetailPane(t *testing.T) {
+	app, _ := newTestApp(t)
+	app.entries[0].Review = "A patient story about time."
+	app.applyFilter(app.entries[0].ID)
+
+	frame := app.View(100, 30)
+	for _, want := range []string{"📌 Title", "Frieren", "⭐ Rating", "5.0 / 5", "💬 Comment", "elves", "📝 Review", "A patient story"} {
+		if !strings.Contains(frame, want) {
+			t.Errorf("detail pane missing %q:\n%s", want, frame)
+		}
+	}
+
+	press(app, "j")
+	frame = app.View(100, 30)
+	for _, want := range []string{"📌 Title : Dune", "🏷️ Tags", "sci-fi"} {
+		if !strings.Contains(frame, want) {
+			t.Errorf("detail pane after move missing %q:\n%s", want, frame)
+		}
+	}
+	if strings.Contains(frame, "A patient story") {
+		t.Errorf("detail pane still shows the previous entry's review:\n%s", frame)
+	}
+}
+
+func TestInlineEdit(t *testing.T) {
+	app, store := newTestApp(t)
+	press(app, "j") // Dune
+
+	// 제목은 그대로, 평점 4 → 3.5, 한줄평 뒤에 덧붙임
+	press(app, "e\r\x7f3.5\r, lots of it\r")
+	if len(store.updates) != 1 {
+		t.Fatalf("UpdateEntry called %d times, want 1", len(store.updates))
+	}
+	updated := store.updates[0]
+	if updated.ID != 2 || updated.Title != "Dune" || updated.Rating != 0.7 || updated.Comment != "sand, lots of it" {
+		t.Errorf("UpdateEntry got %+v", updated)
+	}
+	if updated.Tags[0] != "sci-fi" {
+		t.Errorf("tags = %v, want unchanged", updated.Tags)
+	}
+	assertSelected(t, app, "Dune")
+	if msg := app.Message(); msg != "✅ Updated Dune" {
+		t.Errorf("message = %q", msg)
+	}
+}
+
+func TestInlineEditRejectsInvalidRating(t *testing.T) {
+	app, store := newTestApp(t)
+
+	press(app, "e\r\x7f\x7f\x7f9\r")
+	if len(store.updates) != 0 {
+		t.Fatalf("UpdateEntry called with an invalid rating: %+v", store.updates)
+	}
+	if footer := app.footer(); !strings.Contains(footer, "Edit [3] › Rating") || !strings.Contains(footer, "⚠️") {
+		t.Errorf("footer = %q, want the rating prompt with an error", footer)
+	}
+
+	press(app, "\x1b")
+	if app.mode != modeBrowse || app.Message() != "Cancelled" {
+		t.Errorf("after Esc: mode %v, message %q", app.mode, app.Message())
+	}
+}
+
+func TestRunWithFakeTerminal(t *testing.T) {
+	app, store := newTestApp(t)
+	term := newFakeTerminal("/dune\r+q")
+
+	if err := app.Run(term); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if !app.Done() {
+		t.Error("q did not quit")
+	}
+	if len(store.updates) != 1 || store.updates[0].Rating != 0.9 {
+		t.Fatalf("updates = %+v, want Dune raised to 4.5", store.updates)
+	}
+
+	frame := term.lastFrame()
+	for _, want := range []string{"1 of 3 entries · filter: dune", "⭐ Rated Dune 4.5 / 5"} {
+		if !strings.Contains(frame, want) {
+			t.Errorf("last frame missing %q:\n%s", want, frame)
+		}
+	}
+	if lines := strings.Count(frame, "\r\n") + 1; lines != term.height {
+		t.Errorf("frame has %d lines, want %d", lines, term.height)
+	}
+}
@@ -0,0 +1,88 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// formField 상태 줄에서 입력받는 값 하나
+type formField struct {
+	label    string
+	value    string             // 기본값이자 입력 중인 값
+	validate func(string) error // nil이면 검사하지 않음
+}
+
+// form 추가/수정/평점처럼 여러 값을 차례로 입력받는 양식
+// 마지막 값까지 입력하면 submit을 호출하고, 실패하면 양식을 유지한 채 오류를 표시
+type form struct {
+	title  string
+	fields []formField
+	index  int
+	err    string
+	submit func(values []string) error
+}
+
+func (a *App) openForm(f *form) {
+	a.form = f
+	a.mode = modeForm
+	a.message = ""
+}
+
+func (a *App) closeForm() {
+	a.form = nil
+	a.mode = modeBrowse
+}
+
+func (a *App) handleFormKey(key Key) {
+	f := a.form
+	field := &f.fields[f.index]
+
+	switch key.Kind {
+	case KeyEsc:
+		a.closeForm()
+		a.message = "Cancelled"
+	case KeyBackspace:
+		field.value = dropLastRune(field.value)
+	case KeyRune:
+		field.value += string(key.Rune)
+	case KeyEnter, KeyTab:
+		if field.validate != nil {
+			if err := field.validate(field.value); err != nil {
+				f.err = err.Error()
+				return
+			}
+		}
+		f.err = ""
+
+		if f.index < len(f.fields)-1 {
+			f.index++
+			return
+		}
+
+		values := make([]string, len(f.fields))
+		for i, field := range f.fields {
+			values[i] = field.value
+		}
+		if err := f.submit(values); err != nil {
+			f.err = err.Error()
+			return
+		}
+		a.closeForm()
+	}
+}
+
+// 현재 입력 줄 (예: "Add entry › Rating (0.5-5 in half steps): 4.5")
+func (f *form) prompt() string {
+	field := f.fields[f.index]
+	return fmt.Sprintf("%s › %s: %s", f.title, field.label, field.value)
+}
+
+func requireValue(name string) func(string) error {
+	return func(s string) error {
+		if strings.TrimSpace(s) == "" {
+			return errors.New(name + " must not be empty")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,151 @@
+package tui
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// KeyKind 입력 키 종류
+type KeyKind int
+
+const (
+	KeyRune KeyKind = iota // 일반 문자 (Key.Rune)
+	KeyEnter
+	KeyEsc
+	KeyBackspace
+	KeyDelete
+	KeyTab
+	KeyUp
+	KeyDown
+	KeyLeft
+	KeyRight
+	KeyHome
+	KeyEnd
+	KeyPgUp
+	KeyPgDown
+	KeyCtrlC
+)
+
+// Key 눌린 키 하나
+type Key struct {
+	Kind KeyKind
+	Rune rune
+}
+
+// RuneKey 문자 키
+func RuneKey(r rune) Key {
+	return Key{Kind: KeyRune, Rune: r}
+}
+
+// CSI 시퀀스(ESC [ ... 끝 문자)의 끝 문자별 키
+var csiKeys = map[byte]KeyKind{
+	'A': KeyUp,
+	'B': KeyDown,
+	'C': KeyRight,
+	'D': KeyLeft,
+	'H': KeyHome,
+	'F': KeyEnd,
+}
+
+// ESC [ N ~ 형태의 N별 키
+var tildeKeys = map[string]KeyKind{
+	"1": KeyHome,
+	"7": KeyHome,
+	"4": KeyEnd,
+	"8": KeyEnd,
+	"3": KeyDelete,
+	"5": KeyPgUp,
+	"6": KeyPgDown,
+}
+
+// ParseKeys 터미널 입력 바이트를 키 목록으로 변환
+// 끝에 잘린 UTF-8 문자나 이스케이프 시퀀스가 있으면 rest로 돌려줌 (다음 입력과 이어서 해석)
+func ParseKeys(data []byte) (keys []Key, rest []byte) {
+	for i := 0; i < len(data); {
+		b := data[i]
+		switch {
+		case b == 0x1b:
+			if i+1 == len(data) {
+				// 단독 ESC (시퀀스는 한 번에 들어오므로 입력 끝의 ESC는 ESC 키)
+				keys = append(keys, Key{Kind: KeyEsc})
+				i++
+				continue
+			}
+			if next := data[i+1]; next != '[' && next != 'O' {
+				keys = append(keys, Key{Kind: KeyEsc})
+				i++
+				continue
+			}
+
+			// 매개변수(숫자, ;) 뒤의 끝 문자까지 읽음
+			j := i + 2
+			for j < len(data) && (data[j] >= '0' && data[j] <= '9' || data[j] == ';') {
+				j++
+			}
+			if j == len(data) {
+				return keys, data[i:]
+			}
+			param, final := string(data[i+2:j]), data[j]
+			if final == '~' {
+				if kind, ok := tildeKeys[param]; ok {
+					keys = append(keys, Key{Kind: kind})
+				}
+			} else if kind, ok := csiKeys[final]; ok {
+				keys = append(keys, Key{Kind: kind})
+			}
+			i = j + 1
+		case b == '\r' || b == '\n':
+			keys = append(keys, Key{Kind: KeyEnter})
+			i++
+		case b == 0x7f || b == 0x08:
+			keys = append(keys, Key{Kind: KeyBackspace})
+			i++
+		case b == '\t':
+			keys = append(keys, Key{Kind: KeyTab})
+			i++
+		case b == 0x03:
+			keys = append(keys, Key{Kind: KeyCtrlC})
+			i++
+		case b < 0x20:
+			// 그 밖의 제어 문자는 무시
+			i++
+		default:
+			if !utf8.FullRune(data[i:]) {
+				return keys, data[i:]
+			}
+			r, size := utf8.DecodeRune(data[i:])
+			keys = append(keys, RuneKey(r))
+			i += size
+		}
+	}
+	return keys, nil
+}
+
+// keyReader 입력 스트림에서 키를 하나씩 읽음
+type keyReader struct {
+	r       io.Reader
+	buf     [256]byte
+	pending []byte
+	keys    []Key
+}
+
+func newKeyReader(r io.Reader) *keyReader {
+	return &keyReader{r: r}
+}
+
+// Next 다음 키 (입력이 끝나면 io.EOF)
+func (k *keyReader) Next() (Key, error) {
+	for len(k.keys) == 0 {
+		n, err := k.r.Read(k.buf[:])
+		if n > 0 {
+			k.keys, k.pending = ParseKeys(append(k.pending, k.buf[:n]...))
+		}
+		if err != nil && len(k.keys) == 0 {
+			return Key{}, err
+		}
+	}
+
+	key := k.keys[0]
+	k.keys = k.keys[1:]
+	return key, nil
+}
@@ -0,0 +1,65 @@
+package tui
+
+import (
+	"errors"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// Terminal tui가 그리는 화면과 키 입력
+// 실제 터미널은 OpenTerminal, 테스트에서는 입력을 미리 채운 가짜 터미널을 씀
+type Terminal interface {
+	io.Reader // 키 입력 (원시 모드 바이트)
+	io.Writer // 화면 출력 (ANSI 이스케이프 포함)
+	Size() (width, height int, err error)
+}
+
+// 화면 제어 시퀀스
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[?25l" // 대체 화면으로 전환, 커서 숨김
+	leaveAltScreen = "\x1b[?25h\x1b[?1049l" // 커서 표시, 원래 화면으로 복귀
+)
+
+// TTY 원시 모드로 연 실제 터미널
+type TTY struct {
+	in    *os.File
+	out   *os.File
+	state *term.State
+}
+
+// OpenTerminal in을 원시 모드로 바꾸고 out을 대체 화면으로 전환 (Close로 복구)
+func OpenTerminal(in, out *os.File) (*TTY, error) {
+	if !term.IsTerminal(int(in.Fd())) || !term.IsTerminal(int(out.Fd())) {
+		return nil, errors.New("not running in a terminal")
+	}
+
+	state, err := term.MakeRaw(int(in.Fd()))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.WriteString(out, enterAltScreen); err != nil {
+		term.Restore(int(in.Fd()), state)
+		return nil, err
+	}
+	return &TTY{in: in, out: out, state: state}, nil
+}
+
+func (t *TTY) Read(p []byte) (int, error) {
+	return t.in.Read(p)
+}
+
+func (t *TTY) Write(p []byte) (int, error) {
+	return t.out.Write(p)
+}
+
+func (t *TTY) Size() (int, int, error) {
+	return term.GetSize(int(t.out.Fd()))
+}
+
+// Close 원래 화면과 터미널 모드 복구
+func (t *TTY) Close() error {
+	io.WriteString(t.out, leaveAltScreen)
+	return term.Restore(int(t.in.Fd()), t.state)
+}
@@ -0,0 +1,199 @@
+package tui
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+
+	"github.com/kiku99/morama/internal/render"
+	"github.com/kiku99/morama/internal/utils"
+)
+
+const (
+	reverseVideo = "\x1b[7m"
+	resetStyle   = "\x1b[0m"
+	clearLine    = "\x1b[K"
+
+	chromeLines   = 6 // 제목 줄 + 표 머리(3줄) + 표 아래 테두리 + 상태 줄
+	minTableRows  = 3 // 상세 창을 잘라서라도 확보할 표 행 수
+	reviewPreview = 3 // 상세 창에 보여줄 감상문 줄 수
+)
+
+// 키 도움말 (도움말 화면과 상태 줄 안내)
+var helpLines = []string{
+	"↑/k ↓/j      Move            PgUp/PgDn   Page",
+	"g/Home       First entry     G/End       Last entry",
+	"/            Filter by title, comment, type or tag (Esc clears)",
+	"a            Add an entry    Enter/e     Edit the selected entry",
+	"r            Rate            +/-         Raise or lower the rating one step",
+	"d/Delete     Delete          q/Ctrl-C    Quit",
+}
+
+const footerHint = "↑↓ move  / filter  a add  e edit  r rate  +/- rating  d delete  ? help  q quit"
+
+// View width×height 크기의 화면 (줄은 \r\n으로 구분, 각 줄 끝은 지움)
+func (a *App) View(width, height int) string {
+	// 화면이 낮으면 표 행을 minTableRows만큼 남기고 상세 창 아래쪽을 자름
+	detail := a.detailLines(width)
+	if room := max(height-chromeLines-minTableRows, 0); len(detail) > room {
+		detail = detail[:room]
+	}
+	tableRows := height - chromeLines - len(detail)
+	if tableRows < 1 {
+		tableRows = 1
+	}
+	a.pageSize = tableRows
+	a.scrollTo(tableRows)
+
+	lines := []string{a.titleBar(width)}
+	highlighted := map[int]bool{0: true} // 반전해서 그릴 줄 (제목 줄과 선택된 행)
+	if a.mode == modeHelp {
+		lines = append(lines, helpLines...)
+		for len(lines) < height-1 {
+			lines = append(lines, "")
+		}
+	} else {
+		table, selected := a.tableLines(width, tableRows)
+		if selected >= 0 {
+			highlighted[len(lines)+selected] = true
+		}
+		lines = append(lines, table...)
+		lines = append(lines, detail...)
+	}
+	lines = append(lines, a.footer())
+
+	// 반전은 자른 뒤에 적용 (이스케이프 문자가 폭 계산에 섞이지 않도록)
+	for i, line := range lines {
+		line = runewidth.Truncate(line, width, "")
+		if i == 0 {
+			line = runewidth.FillRight(line, width)
+		}
+		if highlighted[i] {
+			line = reverseVideo + line + resetStyle
+		}
+		lines[i] = line + clearLine
+	}
+	return strings.Join(lines, "\r\n")
+}
+
+// 선택된 항목이 보이도록 표의 시작 위치 조정
+func (a *App) scrollTo(rows int) {
+	if a.cursor < a.offset {
+		a.offset = a.cursor
+	}
+	if a.cursor >= a.offset+rows {
+		a.offset = a.cursor - rows + 1
+	}
+	if maxOffset := len(a.visible) - rows; a.offset > maxOffset {
+		a.offset = max(maxOffset, 0)
+	}
+}
+
+func (a *App) titleBar(width int) string {
+	left := fmt.Sprintf(" morama · %d of %d entries", len(a.visible), len(a.entries))
+	if a.filter != "" {
+		left += fmt.Sprintf(" · filter: %s", a.filter)
+	}
+	right := "? help "
+	gap := width - runewidth.StringWidth(left) - runewidth.StringWidth(right)
+	if gap < 1 {
+		return left
+	}
+	return left + strings.Repeat(" ", gap) + right
+}
+
+// list 명령과 같은 컬럼 레이아웃의 표와 선택된 행의 줄 번호 (보이지 않으면 -1)
+func (a *App) tableLines(width, rows int) ([]string, int) {
+	widths := render.CalculateTableWidths(width)
+	columns := []int{widths.ID, widths.Title, widths.Type, widths.Rating, widths.Date, widths.Comment}
+	border := func(left, mid, right, fill string) string {
+		parts := make([]string, len(columns))
+		for i, w := range columns {
+			parts[i] = strings.Repeat(fill, w)
+		}
+		return left + strings.Join(parts, mid) + right
+	}
+	row := func(sep string, values ...string) string {
+		cells := make([]string, len(values))
+		for i, value := range values {
+			cells[i] = utils.PadStringToWidth(utils.TruncateStringWithWidth(value, columns[i]), columns[i])
+		}
+		return sep + strings.Join(cells, sep) + sep
+	}
+
+	lines := []string{
+		border("┏", "┳", "┓", "━"),
+		row("┃", "ID", "Title", "Type", "Rating", "Date Watched", "Comment"),
+		border("┡", "╇", "┩", "━"),
+	}
+	selected := -1
+
+	for i := 0; i < rows; i++ {
+		index := a.offset + i
+		switch {
+		case index < len(a.visible):
+			entry := a.visible[index]
+			if index == a.cursor {
+				selected = len(lines)
+			}
+			lines = append(lines, row("│", strconv.Itoa(entry.ID), entry.Title, string(entry.Type),
+				a.opts.Scale.Format(entry.Rating), entry.DateWatched.Format(a.opts.DateFormat), entry.Comment))
+		case i == 0 && a.filter != "":
+			lines = append(lines, "  No entries match the filter (Esc clears it)")
+		case i == 0:
+			lines = append(lines, "  No entries yet — press a to add one")
+		default:
+			lines = append(lines, "")
+		}
+	}
+
+	return append(lines, border("└", "┴", "┘", "─")), selected
+}
+
+// 선택된 항목의 상세 창 (show와 같은 필드, 감상문은 앞부분만)
+func (a *App) detailLines(width int) []string {
+	entry, ok := a.Selected()
+	if !ok {
+		return nil
+	}
+
+	var lines []string
+	for _, field := range render.EntryFields(entry, a.opts.Scale) {
+		lines = append(lines, " "+field.String(6))
+	}
+	if entry.Review != "" {
+		review := utils.WrapText(entry.Review, width-4)
+		if len(review) > reviewPreview {
+			review = append(review[:reviewPreview:reviewPreview], "…")
+		}
+		lines = append(lines, " 📝 Review :")
+		for _, line := range review {
+			lines = append(lines, "   "+line)
+		}
+	}
+	return lines
+}
+
+func (a *App) footer() string {
+	switch a.mode {
+	case modeFilter:
+		return "/" + a.filter + "█"
+	case modeForm:
+		line := a.form.prompt() + "█"
+		if a.form.err != "" {
+			line += "  ⚠️ " + a.form.err
+		}
+		return line
+	case modeConfirm:
+		entry, _ := a.Selected()
+		return fmt.Sprintf("Delete [%d] %s (%s)? y/N", entry.ID, entry.Title, entry.Type.Label())
+	case modeHelp:
+		return "Press any key to go back"
+	}
+	if a.message != "" {
+		return a.message
+	}
+	return footerHint
+}
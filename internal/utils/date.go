@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 날짜 입력으로 허용하는 절대 형식
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006/01/02",
+	"2006.01.02",
+	"20060102",
+}
+
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "sun": time.Sunday,
+	"monday": time.Monday, "mon": time.Monday,
+	"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+	"wednesday": time.Wednesday, "wed": time.Wednesday,
+	"thursday": time.Thursday, "thu": time.Thursday, "thurs": time.Thursday,
+	"friday": time.Friday, "fri": time.Friday,
+	"saturday": time.Saturday, "sat": time.Saturday,
+}
+
+// ParseDate YYYY-MM-DD 또는 자연어 날짜(today, yesterday, last friday, 3 days ago)를
+// now 기준 로컬 자정 시각으로 변환
+func ParseDate(input string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(input), " "))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, now.Location()); err == nil {
+			return t, nil
+		}
+	}
+
+	switch s {
+	case "today", "오늘":
+		return today, nil
+	case "yesterday", "어제":
+		return today.AddDate(0, 0, -1), nil
+	case "그제", "그저께":
+		return today.AddDate(0, 0, -2), nil
+	}
+
+	// "friday" → 오늘 포함 가장 최근 금요일, "last friday" → 오늘 이전의 가장 최근 금요일
+	if wd, ok := weekdays[strings.TrimPrefix(s, "last ")]; ok {
+		diff := (int(today.Weekday()) - int(wd) + 7) % 7
+		if diff == 0 && strings.HasPrefix(s, "last ") {
+			diff = 7
+		}
+		return today.AddDate(0, 0, -diff), nil
+	}
+
+	// "3 days ago", "2 weeks ago", "1 month ago"
+	if fields := strings.Fields(s); len(fields) == 3 && fields[2] == "ago" {
+		n, err := strconv.Atoi(fields[0])
+		if err == nil && n >= 0 {
+			switch strings.TrimSuffix(fields[1], "s") {
+			case "day":
+				return today.AddDate(0, 0, -n), nil
+			case "week":
+				return today.AddDate(0, 0, -7*n), nil
+			case "month":
+				return today.AddDate(0, -n, 0), nil
+			case "year":
+				return today.AddDate(-n, 0, 0), nil
+			}
+		}
+	}
+
+	switch s {
+	case "last week":
+		return today.AddDate(0, 0, -7), nil
+	case "last month":
+		return today.AddDate(0, -1, 0), nil
+	}
+
+	return time.Time{}, fmt.Errorf("unrecognized date %q (use YYYY-MM-DD, today, yesterday, last friday, 3 days ago)", input)
+}
+
+// ParseAge "30d", "2w", "6m", "1y", "12h" 같은 기간을 now에서 뺀 시각으로 변환
+// (단위: h 시간, d 일, w 주, m 개월, y 년)
+func ParseAge(input string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	invalid := fmt.Errorf("invalid age %q (use a number with h, d, w, m or y, e.g. 30d)", input)
+	if len(s) < 2 {
+		return time.Time{}, invalid
+	}
+
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n < 0 {
+		return time.Time{}, invalid
+	}
+	switch s[len(s)-1] {
+	case 'h':
+		return now.Add(-time.Duration(n) * time.Hour), nil
+	case 'd':
+		return now.AddDate(0, 0, -n), nil
+	case 'w':
+		return now.AddDate(0, 0, -7*n), nil
+	case 'm':
+		return now.AddDate(0, -n, 0), nil
+	case 'y':
+		return now.AddDate(-n, 0, 0), nil
+	}
+	return time.Time{}, invalid
+}
@@ -0,0 +1,114 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/width"
+)
+
+var titleFolder = cases.Fold()
+
+// NormalizeTitle 비교용 제목 정규화
+// 전각/반각 통일, 호환 자모·분리된 자모를 완성형으로 조합, 대소문자 무시, 공백·문장부호 제거
+func NormalizeTitle(s string) string {
+	s = norm.NFKC.String(width.Fold.String(s))
+	s = titleFolder.String(s)
+
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			b.WriteRune(r)
+		}
+	}
+	return norm.NFC.String(b.String())
+}
+
+// TitleMatches 정규화한 제목이 같거나 query가 제목의 일부인지
+func TitleMatches(title, query string) bool {
+	t, q := NormalizeTitle(title), NormalizeTitle(query)
+	if q == "" {
+		return false
+	}
+	return strings.Contains(t, q)
+}
+
+// TitleDistance 정규화한 두 제목의 편집 거리
+// 한글은 자모 단위로 비교해서 받침 하나 틀린 정도는 거리 1로 계산
+func TitleDistance(a, b string) int {
+	return Levenshtein(
+		norm.NFD.String(NormalizeTitle(a)),
+		norm.NFD.String(NormalizeTitle(b)),
+	)
+}
+
+// Levenshtein 룬 단위 편집 거리
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// SuggestTitles query와 편집 거리가 가까운 제목을 가까운 순으로 최대 limit개 반환
+func SuggestTitles(query string, titles []string, limit int) []string {
+	type candidate struct {
+		title    string
+		distance int
+	}
+
+	decomposed := []rune(norm.NFD.String(NormalizeTitle(query)))
+	maxDistance := MaxInt(2, len(decomposed)/3)
+
+	seen := make(map[string]bool)
+	var candidates []candidate
+	for _, title := range titles {
+		if seen[title] {
+			continue
+		}
+		seen[title] = true
+
+		if d := TitleDistance(query, title); d <= maxDistance {
+			candidates = append(candidates, candidate{title, d})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	var suggestions []string
+	for _, c := range candidates {
+		if limit > 0 && len(suggestions) >= limit {
+			break
+		}
+		suggestions = append(suggestions, c.title)
+	}
+	return suggestions
+}
@@ -1,8 +1,10 @@
 package utils
 
 import (
+	"fmt"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/mattn/go-runewidth"
 )
@@ -43,6 +45,148 @@ func MaxInt(a, b int) int {
 	return b
 }
 
+func MinInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 func ParseID(input string) (int, error) {
 	return strconv.Atoi(input)
 }
+
+// MaxIDListSize ParseIDList가 한 번에 받는 ID 수 상한 (범위를 펼친 뒤 기준)
+const MaxIDListSize = 10000
+
+// ParseIDList "12", "10-20", "3,5" 형태의 인자를 ID 목록으로 변환 (중복 제거, 순서 유지)
+// 범위는 펼치기 전에 크기를 확인해 MaxIDListSize를 넘으면 오류
+func ParseIDList(args []string) ([]int, error) {
+	seen := make(map[int]bool)
+	var ids []int
+	add := func(id int) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, arg := range args {
+		for _, part := range strings.Split(arg, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+
+			if from, to, isRange := strings.Cut(part, "-"); isRange {
+				start, err1 := ParseID(from)
+				end, err2 := ParseID(to)
+				if err1 != nil || err2 != nil || start <= 0 || end < start {
+					return nil, fmt.Errorf("invalid ID range %q", part)
+				}
+				if end-start >= MaxIDListSize-len(ids) {
+					return nil, fmt.Errorf("ID range %q is too large (at most %d IDs at once)", part, MaxIDListSize)
+				}
+				for id := start; id <= end; id++ {
+					add(id)
+				}
+				continue
+			}
+
+			id, err := ParseID(part)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("invalid ID %q", part)
+			}
+			add(id)
+			if len(ids) > MaxIDListSize {
+				return nil, fmt.Errorf("too many IDs (at most %d at once)", MaxIDListSize)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// ParseTags "sf, 스릴러" 형태의 인자를 태그 목록으로 변환
+// 앞뒤/연속 공백 정리, 빈 값 제거, 대소문자 무시 중복 제거 (순서 유지)
+func ParseTags(args []string) []string {
+	seen := make(map[string]bool)
+	var tags []string
+	for _, arg := range args {
+		for _, part := range strings.Split(arg, ",") {
+			tag := strings.Join(strings.Fields(part), " ")
+			key := strings.ToLower(tag)
+			if tag == "" || seen[key] {
+				continue
+			}
+			seen[key] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// WrapText 표시 폭(width) 안에 들어가도록 단어 단위로 줄바꿈 (기존 줄바꿈과 빈 줄은 유지)
+// 한 단어가 width보다 길면 글자 단위로 자름
+func WrapText(text string, width int) []string {
+	if width < 1 {
+		width = 1
+	}
+
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		words := strings.Fields(paragraph)
+		if len(words) == 0 {
+			lines = append(lines, "")
+			continue
+		}
+
+		// 들여쓰기(목록, 인용 등)는 첫 줄에만 유지
+		line := paragraph[:len(paragraph)-len(strings.TrimLeft(paragraph, " \t"))]
+		lineWidth := runewidth.StringWidth(line)
+		hasText := false
+		for _, word := range words {
+			wordWidth := runewidth.StringWidth(word)
+			if hasText && lineWidth+1+wordWidth > width {
+				lines = append(lines, line)
+				line, lineWidth, hasText = "", 0, false
+			}
+			if hasText {
+				line += " "
+				lineWidth++
+			}
+
+			for lineWidth+wordWidth > width {
+				head, rest := splitAtWidth(word, width-lineWidth)
+				if head == "" && line == "" {
+					// 폭보다 넓은 글자 하나는 그대로 한 줄에
+					_, size := utf8.DecodeRuneInString(word)
+					line, lineWidth = word[:size], width
+					word, wordWidth = word[size:], runewidth.StringWidth(word[size:])
+					continue
+				}
+				lines = append(lines, line+head)
+				line, lineWidth = "", 0
+				word, wordWidth = rest, runewidth.StringWidth(rest)
+			}
+			line += word
+			lineWidth += wordWidth
+			hasText = true
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// s를 표시 폭 width까지의 앞부분과 나머지로 나눔
+func splitAtWidth(s string, width int) (string, string) {
+	current := 0
+	for i, r := range s {
+		w := runewidth.RuneWidth(r)
+		if current+w > width {
+			return s[:i], s[i:]
+		}
+		current += w
+	}
+	return s, ""
+}
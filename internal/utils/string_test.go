@@ -0,0 +1,61 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIDList(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []int
+		wantLen int    // want 대신 개수만 확인
+		wantErr string // 비어 있으면 성공
+	}{
+		{name: "single", args: []string{"12"}, want: []int{12}},
+		{name: "range", args: []string{"10-13"}, want: []int{10, 11, 12, 13}},
+		{name: "one-ID range", args: []string{"7-7"}, want: []int{7}},
+		{name: "comma list", args: []string{"3,5", "1"}, want: []int{3, 5, 1}},
+		{name: "spaces and empty parts", args: []string{" 3 , ,5,"}, want: []int{3, 5}},
+		{name: "duplicates keep first position", args: []string{"5", "3-6", "5,3"}, want: []int{5, 3, 4, 6}},
+		{name: "no args", args: nil, want: nil},
+		{name: "zero", args: []string{"0"}, wantErr: `invalid ID "0"`},
+		{name: "not a number", args: []string{"abc"}, wantErr: `invalid ID "abc"`},
+		{name: "negative", args: []string{"-5"}, wantErr: `invalid ID range "-5"`},
+		{name: "open range", args: []string{"5-"}, wantErr: `invalid ID range "5-"`},
+		{name: "reversed range", args: []string{"20-10"}, wantErr: `invalid ID range "20-10"`},
+		{name: "range from zero", args: []string{"0-3"}, wantErr: `invalid ID range "0-3"`},
+		{name: "three-part range", args: []string{"1-2-3"}, wantErr: `invalid ID range "1-2-3"`},
+		{name: "range at the cap", args: []string{fmt.Sprintf("1-%d", MaxIDListSize)}, wantLen: MaxIDListSize},
+		{name: "range over the cap", args: []string{fmt.Sprintf("1-%d", MaxIDListSize+1)}, wantErr: "is too large"},
+		{name: "huge range", args: []string{"1-999999999"}, wantErr: `ID range "1-999999999" is too large`},
+		{name: "ranges over the cap together", args: []string{"1-6000", "10001-16000"}, wantErr: `ID range "10001-16000" is too large`},
+		{name: "single ID past a full range", args: []string{fmt.Sprintf("1-%d", MaxIDListSize), "20000"}, wantErr: "too many IDs"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIDList(tt.args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ParseIDList(%q) error = %v, want %q", tt.args, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseIDList(%q): %v", tt.args, err)
+			}
+			if tt.wantLen > 0 {
+				if len(got) != tt.wantLen {
+					t.Errorf("ParseIDList(%q) returned %d IDs, want %d", tt.args, len(got), tt.wantLen)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseIDList(%q) = %v, want %v", tt.args, got, tt.want)
+			}
+		})
+	}
+}